@@ -19,12 +19,14 @@ import (
 	"encoding/json"
 
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/user"
 	"regexp"
 	"strconv"
+	"strings"
 
 	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
 	"github.com/kylelemons/go-gypsy/yaml"
@@ -32,6 +34,8 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	flag "github.com/juju/gnuflag"
 )
@@ -43,6 +47,7 @@ var id = flag.Int64("id", -1, "id")
 var version = flag.Int("version", -1, "version")
 var subtype = flag.Int("subtype", -1, "subarea type id")
 var itemtype = flag.Int("itemtype", -1, "item type id")
+var childtype = flag.Int("childtype", -1, "child subarea type id")
 var facility = flag.Int64("facility", -1, "facility_id")
 var parent = flag.Int64("parent", 0, "parent_id")
 var position = flag.Int("position", -1, "position")
@@ -54,6 +59,33 @@ var serial = flag.String("serial", "", "serial")
 var product = flag.Int64("product", -1, "product_id")
 var json_data = flag.String("j", "", "json extension data")
 var entity_name = flag.String("entity_name", "", "name of entity to be extended")
+var file = flag.String("file", "", "path to input file")
+var items = flag.Bool("items", false, "include inventory items")
+var auto = flag.Bool("auto", false, "assign the next position")
+var ids = flag.String("ids", "", "comma separated list of ids")
+var page_size = flag.Int("page_size", 0, "maximum results per page")
+var page_token = flag.String("page_token", "", "token for the next page")
+var total = flag.Bool("total", false, "include total count")
+var filter = flag.String("filter", "", "filter expression")
+var order_by = flag.String("order_by", "", "fields to sort by")
+var query = flag.String("query", "", "search words")
+var json_path = flag.String("path", "", "json path of an extension field")
+var value_type = flag.String("value_type", "", "type of an extension field, string or number")
+var resume_token = flag.String("resume_token", "", "token to resume a watch")
+var cursor = flag.String("cursor", "", "cursor from the last get_changes")
+var deleted = flag.Bool("deleted", false, "include deleted records")
+var request_id = flag.String("request_id", "", "idempotency key of a create")
+var reassign_to = flag.Int64("reassign_to", 0, "replacement for the dependents of a delete")
+var check_existing = flag.Bool("check_existing", false, "check existing records against a new entity schema")
+var force = flag.Bool("force", false, "update an entity schema that existing records do not match")
+
+// update command flags and the request fields they set, only given flags are updated
+var facilityUpdateFields = map[string]string{"name": "facility_name", "j": "json_data"}
+var subareaUpdateFields = map[string]string{"parent": "parent_subarea_id", "position": "position", "auto": "position",
+	"subtype": "subarea_type_id", "name": "subarea_name", "j": "json_data"}
+var productUpdateFields = map[string]string{"sku": "sku", "name": "product_name", "comment": "comment", "j": "json_data"}
+var itemUpdateFields = map[string]string{"subarea": "subarea_id", "itemtype": "item_type_id", "quantity": "quantity",
+	"serial": "serial_number", "product": "product_id", "j": "json_data"}
 
 func main() {
 	flag.Parse(true)
@@ -85,50 +117,76 @@ func main() {
 		prog := os.Args[0]
 		fmt.Printf("Command line client for inventory grpc service\n")
 		fmt.Printf("usage:\n")
-		fmt.Printf("    %s create_facility --name <name> [-j <json_data]\n", prog)
-		fmt.Printf("    %s update_facility --id <facility_id> --version <version> --name <name> [-j <json_data]\n", prog)
+		fmt.Printf("    %s create_facility --name <name> [-j <json_data] [--request_id <key>]\n", prog)
+		fmt.Printf("    %s update_facility --id <facility_id> --version <version> [--name <name>] [-j <json_data]\n", prog)
 		fmt.Printf("    %s delete_facility --id <facility_id> --version <version>\n", prog)
-		fmt.Printf("    %s get_facility --id <facility_id>\n", prog)
-		fmt.Printf("    %s get_facilities\n", prog)
+		fmt.Printf("    %s undelete_facility --id <facility_id> --version <version>\n", prog)
+		fmt.Printf("    %s get_facility --id <facility_id> [--deleted]\n", prog)
+		fmt.Printf("    %s get_facilities [--page_size <n>] [--page_token <token>] [--total] [--deleted]\n", prog)
 		fmt.Printf("    %s get_facility_wrapper --id <facility_id>\n", prog)
+		fmt.Printf("    %s clone_facility --id <facility_id> --name <facility_name> [--items]\n", prog)
 
-		fmt.Printf("    %s create_subarea_type  --id <subarea_type_id> --name <name>\n", prog)
+		fmt.Printf("    %s create_subarea_type  --id <subarea_type_id> --name <name> [--request_id <key>]\n", prog)
 		fmt.Printf("    %s update_subarea_type  --id <subarea_type_id> --name <name> --version <version>\n", prog)
-		fmt.Printf("    %s delete_subarea_type  --id <subarea_type_id> --version <version>\n", prog)
+		fmt.Printf("    %s delete_subarea_type  --id <subarea_type_id> --version <version> [--reassign_to <subarea_type_id>]\n", prog)
 		fmt.Printf("    %s get_subarea_type  --id <subarea_type_id>\n", prog)
 		fmt.Printf("    %s get_subarea_types\n", prog)
 
-		fmt.Printf("    %s create_item_type  --id <item_type_id> --name <name>\n", prog)
+		fmt.Printf("    %s create_item_type  --id <item_type_id> --name <name> [--request_id <key>]\n", prog)
 		fmt.Printf("    %s update_item_type  --id <item_type_id> --name <name> --version <version>\n", prog)
-		fmt.Printf("    %s delete_item_type  --id <item_type_id> --version <version>\n", prog)
+		fmt.Printf("    %s delete_item_type  --id <item_type_id> --version <version> [--reassign_to <item_type_id>]\n", prog)
 		fmt.Printf("    %s get_item_type  --id <item_type_id>\n", prog)
 		fmt.Printf("    %s get_item_types\n", prog)
-
-		fmt.Printf("    %s create_subarea --facility <facility_id>  [--parent <subarea_id>] --position <position> --subtype <subarea_type_id> --name <name> [-j <json_data]\n", prog)
-		fmt.Printf("    %s update_subarea --id <subarea_id>  [--parent <subarea_id>] --position <position> --subtype <subarea_type_id> --name <name> --version <version> [-j <json_data]\n", prog)
+		fmt.Printf("    %s add_subarea_type_nesting --subtype <parent_subarea_type_id> --childtype <child_subarea_type_id>\n", prog)
+		fmt.Printf("    %s remove_subarea_type_nesting --subtype <parent_subarea_type_id> --childtype <child_subarea_type_id>\n", prog)
+		fmt.Printf("    %s get_subarea_type_nestings\n", prog)
+		fmt.Printf("    %s add_subarea_item_type --subtype <subarea_type_id> --itemtype <item_type_id>\n", prog)
+		fmt.Printf("    %s remove_subarea_item_type --subtype <subarea_type_id> --itemtype <item_type_id>\n", prog)
+		fmt.Printf("    %s get_subarea_item_types\n", prog)
+
+		fmt.Printf("    %s create_subarea --facility <facility_id>  [--parent <subarea_id>] --position <position> | --auto --subtype <subarea_type_id> --name <name> [-j <json_data] [--request_id <key>]\n", prog)
+		fmt.Printf("    %s update_subarea --id <subarea_id> --version <version> [--parent <subarea_id>] [--position <position> | --auto] [--subtype <subarea_type_id>] [--name <name>] [-j <json_data]\n", prog)
 		fmt.Printf("    %s delete_subarea --id <subarea_id> --version <version>\n", prog)
-		fmt.Printf("    %s get_subarea --id <subarea_id>\n", prog)
-		fmt.Printf("    %s get_subareas --facility <facility_id>\n", prog)
-
-		fmt.Printf("    %s create_product --name <name> [--sku <sku>] [--comment <comment>] [-j <json_data]\n", prog)
-		fmt.Printf("    %s update_product --id <product_id> --name <name> [--sku <sku>] [--comment <comment>] --version <version> [-j <json_data]\n", prog)
-		fmt.Printf("    %s delete_product --id <product_id> --version <version>\n", prog)
-		fmt.Printf("    %s get_product --id <product_id>\n", prog)
-		fmt.Printf("    %s get_products\n", prog)
-
-		fmt.Printf("    %s create_item --subarea <subarea_id> --itemtype <item_type_id> --quantity <quantity> [--serial <serial_number>] --product <product_id> [-j <json_data]\n", prog)
-		fmt.Printf("    %s update_item  --id <item_id> --version <version> --subarea <subarea_id> --itemtype <item_type_id> --quantity <quantity> [--serial <serial_number>] --product <product_id> [-j <json_data]\n", prog)
+		fmt.Printf("    %s undelete_subarea --id <subarea_id> --version <version>\n", prog)
+		fmt.Printf("    %s get_subarea --id <subarea_id> [--deleted]\n", prog)
+		fmt.Printf("    %s get_subareas --facility <facility_id> [--page_size <n>] [--page_token <token>] [--total] [--deleted]\n", prog)
+		fmt.Printf("    %s get_subareas_by_ids --ids <subarea_id,...>\n", prog)
+		fmt.Printf("    %s stream_subareas --facility <facility_id>\n", prog)
+		fmt.Printf("    %s apply_template --facility <facility_id> [--parent <subarea_id>] --file <template_yaml>\n", prog)
+		fmt.Printf("    %s reorder_subareas --facility <facility_id> [--parent <subarea_id>] --ids <subarea_id,...>\n", prog)
+
+		fmt.Printf("    %s create_product --name <name> [--sku <sku>] [--comment <comment>] [-j <json_data] [--request_id <key>]\n", prog)
+		fmt.Printf("    %s update_product --id <product_id> --version <version> [--name <name>] [--sku <sku>] [--comment <comment>] [-j <json_data]\n", prog)
+		fmt.Printf("    %s delete_product --id <product_id> --version <version> [--reassign_to <product_id>]\n", prog)
+		fmt.Printf("    %s undelete_product --id <product_id> --version <version>\n", prog)
+		fmt.Printf("    %s get_product --id <product_id> [--deleted]\n", prog)
+		fmt.Printf("    %s get_products [--filter <filter>] [--order_by <fields>] [--page_size <n>] [--page_token <token>] [--total] [--deleted]\n", prog)
+		fmt.Printf("    %s get_products_by_ids --ids <product_id,...>\n", prog)
+		fmt.Printf("    %s stream_products [--filter <filter>] [--order_by <fields>]\n", prog)
+		fmt.Printf("    %s search_products --query <words> [--page_size <n>] [--page_token <token>]\n", prog)
+
+		fmt.Printf("    %s create_item --subarea <subarea_id> --itemtype <item_type_id> --quantity <quantity> [--serial <serial_number>] --product <product_id> [-j <json_data] [--request_id <key>]\n", prog)
+		fmt.Printf("    %s update_item  --id <item_id> --version <version> [--subarea <subarea_id>] [--itemtype <item_type_id>] [--quantity <quantity>] [--serial <serial_number>] [--product <product_id>] [-j <json_data]\n", prog)
 		fmt.Printf("    %s delete_item  --id <item_id> --version <version>\n", prog)
-		fmt.Printf("    %s get_item  --id <item_id>\n", prog)
-		fmt.Printf("    %s get_items_by_product --product <product_id>\n", prog)
-		fmt.Printf("    %s get_items_by_subarea --subarea <subarea_id>\n", prog)
-		fmt.Printf("    %s get_items_by_facility --facility <facility_id>\n", prog)
-
-		fmt.Printf("    %s create_entity_schema --entity_name <entity_name> -j <json_schema> \n", prog)
-		fmt.Printf("    %s update_entity_schema --entity_name <entity_name> -j <json_schema> \n", prog)
+		fmt.Printf("    %s undelete_item  --id <item_id> --version <version>\n", prog)
+		fmt.Printf("    %s get_item  --id <item_id> [--deleted]\n", prog)
+		fmt.Printf("    %s get_items_by_product --product <product_id> [--filter <filter>] [--order_by <fields>] [--page_size <n>] [--page_token <token>] [--total] [--deleted]\n", prog)
+		fmt.Printf("    %s get_items_by_subarea --subarea <subarea_id> [--filter <filter>] [--order_by <fields>] [--page_size <n>] [--page_token <token>] [--total] [--deleted]\n", prog)
+		fmt.Printf("    %s get_items_by_facility --facility <facility_id> [--filter <filter>] [--order_by <fields>] [--page_size <n>] [--page_token <token>] [--total] [--deleted]\n", prog)
+		fmt.Printf("    %s get_items_by_ids --ids <item_id,...>\n", prog)
+		fmt.Printf("    %s stream_items_by_facility --facility <facility_id> [--filter <filter>] [--order_by <fields>]\n", prog)
+		fmt.Printf("    %s watch_inventory --facility <facility_id> | --subarea <subarea_id> | --product <product_id> [--resume_token <token>]\n", prog)
+		fmt.Printf("    %s get_changes [--cursor <cursor>] [--page_size <n>]\n", prog)
+
+		fmt.Printf("    %s create_entity_schema --entity_name <entity_name> -j <json_schema> [--request_id <key>]\n", prog)
+		fmt.Printf("    %s update_entity_schema --entity_name <entity_name> -j <json_schema> [--check_existing] [--force]\n", prog)
 		fmt.Printf("    %s delete_entity_schema --entity_name <entity_name>\n", prog)
 		fmt.Printf("    %s get_entity_schema --entity_name <entity_name>\n", prog)
 		fmt.Printf("    %s get_entity_schemas\n", prog)
+		fmt.Printf("    %s create_json_index --entity_name <entity_name> --path <json_path> --value_type <string|number> [--request_id <key>]\n", prog)
+		fmt.Printf("    %s delete_json_index --entity_name <entity_name> --path <json_path> --value_type <string|number>\n", prog)
+		fmt.Printf("    %s get_json_indexes\n", prog)
+		fmt.Printf("    %s batch --file <batch_json>\n", prog)
 
 
 		fmt.Printf("    %s get_server_version\n", prog)
@@ -146,8 +204,8 @@ func main() {
 			validParams = false
 		}
 	case "update_facility":
-		if *name == "" {
-			fmt.Println("name parameter missing")
+		if len(flagUpdateMask(facilityUpdateFields).GetPaths()) == 0 {
+			fmt.Println("no fields to update")
 			validParams = false
 		}
 		if *id == -1 {
@@ -159,7 +217,7 @@ func main() {
 			validParams = false
 		}
 
-	case "delete_facility":
+	case "delete_facility", "undelete_facility":
 		if *id == -1 {
 			fmt.Println("id parameter missing")
 			validParams = false
@@ -185,6 +243,16 @@ func main() {
 			validParams = false
 		}
 
+	case "clone_facility":
+		if *id == -1 {
+			fmt.Println("id parameter missing")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+
 	case "create_subarea_type":
 		if *id == -1 {
 			fmt.Println("id parameter missing")
@@ -273,13 +341,41 @@ func main() {
 		// no parameters
 		validParams = true
 
+	case "add_subarea_type_nesting", "remove_subarea_type_nesting":
+		if *subtype == -1 {
+			fmt.Println("subtype parameter missing")
+			validParams = false
+		}
+		if *childtype == -1 {
+			fmt.Println("childtype parameter missing")
+			validParams = false
+		}
+
+	case "get_subarea_type_nestings":
+		// no parameters
+		validParams = true
+
+	case "add_subarea_item_type", "remove_subarea_item_type":
+		if *subtype == -1 {
+			fmt.Println("subtype parameter missing")
+			validParams = false
+		}
+		if *itemtype == -1 {
+			fmt.Println("itemtype parameter missing")
+			validParams = false
+		}
+
+	case "get_subarea_item_types":
+		// no parameters
+		validParams = true
+
 	case "create_subarea":
 		if *facility == -1 {
 			fmt.Println("facility parameter missing")
 			validParams = false
 		}
 
-		if *position == -1 {
+		if (*position == -1) && !*auto {
 			fmt.Println("position parameter missing")
 			validParams = false
 		}
@@ -297,24 +393,32 @@ func main() {
 			fmt.Println("id parameter missing")
 			validParams = false
 		}
-		if *position == -1 {
-			fmt.Println("position parameter missing")
+		if len(flagUpdateMask(subareaUpdateFields).GetPaths()) == 0 {
+			fmt.Println("no fields to update")
 			validParams = false
 		}
-		if *subtype == -1 {
-			fmt.Println("subtype parameter missing")
+		if *version == -1 {
+			fmt.Println("version parameter missing")
 			validParams = false
 		}
-		if *name == "" {
-			fmt.Println("name parameter missing")
+
+	case "get_subareas_by_ids", "get_products_by_ids", "get_items_by_ids":
+		if !idlistValidator.MatchString(*ids) {
+			fmt.Println("ids parameter missing or not a comma separated list of ids")
 			validParams = false
 		}
-		if *version == -1 {
-			fmt.Println("version parameter missing")
+
+	case "reorder_subareas":
+		if *facility == -1 {
+			fmt.Println("facility parameter missing")
+			validParams = false
+		}
+		if !idlistValidator.MatchString(*ids) {
+			fmt.Println("ids parameter missing or not a comma separated list of ids")
 			validParams = false
 		}
 
-	case "delete_subarea":
+	case "delete_subarea", "undelete_subarea":
 		if *id == -1 {
 			fmt.Println("id parameter missing")
 			validParams = false
@@ -330,11 +434,21 @@ func main() {
 			validParams = false
 		}
 
-	case "get_subareas":
+	case "get_subareas", "stream_subareas":
+		if *facility == -1 {
+			fmt.Println("facility parameter missing")
+			validParams = false
+		}
+
+	case "apply_template":
 		if *facility == -1 {
 			fmt.Println("facility parameter missing")
 			validParams = false
 		}
+		if *file == "" {
+			fmt.Println("file parameter missing")
+			validParams = false
+		}
 
 	case "create_product":
 		if *name == "" {
@@ -342,8 +456,8 @@ func main() {
 			validParams = false
 		}
 	case "update_product":
-		if *name == "" {
-			fmt.Println("name parameter missing")
+		if len(flagUpdateMask(productUpdateFields).GetPaths()) == 0 {
+			fmt.Println("no fields to update")
 			validParams = false
 		}
 		if *id == -1 {
@@ -355,7 +469,7 @@ func main() {
 			validParams = false
 		}
 
-	case "delete_product":
+	case "delete_product", "undelete_product":
 		if *id == -1 {
 			fmt.Println("id parameter missing")
 			validParams = false
@@ -369,10 +483,16 @@ func main() {
 			fmt.Println("id parameter missing")
 			validParams = false
 		}
-	case "get_products":
+	case "get_products", "stream_products":
 		// no parameters
 		validParams = true
 
+	case "search_products":
+		if *query == "" {
+			fmt.Println("query parameter missing")
+			validParams = false
+		}
+
 	case "create_item":
 		if *subarea == -1 {
 			fmt.Println("subarea parameter missing")
@@ -400,23 +520,11 @@ func main() {
 			fmt.Println("version parameter missing")
 			validParams = false
 		}
-		if *subarea == -1 {
-			fmt.Println("subarea parameter missing")
-			validParams = false
-		}
-		if *itemtype == -1 {
-			fmt.Println("itemtype parameter missing")
-			validParams = false
-		}
-		if *quantity == -1 {
-			fmt.Println("itemtype parameter missing")
-			validParams = false
-		}
-		if *product == -1 {
-			fmt.Println("product parameter missing")
+		if len(flagUpdateMask(itemUpdateFields).GetPaths()) == 0 {
+			fmt.Println("no fields to update")
 			validParams = false
 		}
-	case "delete_item":
+	case "delete_item", "undelete_item":
 		if *id == -1 {
 			fmt.Println("id parameter missing")
 			validParams = false
@@ -440,12 +548,24 @@ func main() {
 			fmt.Println("subarea parameter missing")
 			validParams = false
 		}
-	case "get_items_by_facility":
+	case "get_items_by_facility", "stream_items_by_facility":
 		if *facility == -1 {
 			fmt.Println("facility parameter missing")
 			validParams = false
 		}
 
+	case "watch_inventory":
+		scopes := 0
+		for _, scopeId := range []int64{*facility, *subarea, *product} {
+			if scopeId != -1 {
+				scopes++
+			}
+		}
+		if scopes != 1 {
+			fmt.Println("exactly one of facility, subarea or product parameters required")
+			validParams = false
+		}
+
 	case "create_entity_schema":
 
 		if *entity_name == "" {
@@ -478,6 +598,27 @@ func main() {
 	case "get_entity_schemas":
 		// no parameters
 		validParams = true
+	case "create_json_index", "delete_json_index":
+		if *entity_name == "" {
+			fmt.Println("entity_name parameter missing")
+			validParams = false
+		}
+		if *json_path == "" {
+			fmt.Println("path parameter missing")
+			validParams = false
+		}
+		if *value_type == "" {
+			fmt.Println("value_type parameter missing")
+			validParams = false
+		}
+	case "get_json_indexes":
+		// no parameters
+		validParams = true
+	case "batch":
+		if *file == "" {
+			fmt.Println("file parameter missing")
+			validParams = false
+		}
 	case "get_server_version":
 		// no parameters
 		validParams = true
@@ -548,6 +689,7 @@ func main() {
 	switch cmd {
 	case "create_facility":
 		req := pb.CreateFacilityRequest{}
+		req.RequestId = *request_id
 		req.FacilityName = *name
 		req.JsonData = *json_data
 		resp, err := client.CreateFacility(mctx, &req)
@@ -559,6 +701,7 @@ func main() {
 		req.Version = int32(*version)
 		req.FacilityName = *name
 		req.JsonData = *json_data
+		req.UpdateMask = flagUpdateMask(facilityUpdateFields)
 		resp, err := client.UpdateFacility(mctx, &req)
 		printResponse(resp, err)
 
@@ -569,14 +712,26 @@ func main() {
 		resp, err := client.DeleteFacility(mctx, &req)
 		printResponse(resp, err)
 
+	case "undelete_facility":
+		req := pb.UndeleteFacilityRequest{}
+		req.FacilityId = *id
+		req.Version = int32(*version)
+		resp, err := client.UndeleteFacility(mctx, &req)
+		printResponse(resp, err)
+
 	case "get_facility":
 		req := pb.GetFacilityRequest{}
 		req.FacilityId = *id
+		req.IncludeDeleted = *deleted
 		resp, err := client.GetFacility(mctx, &req)
 		printResponse(resp, err)
 
 	case "get_facilities":
 		req := pb.GetFacilitiesRequest{}
+		req.PageSize = int32(*page_size)
+		req.PageToken = *page_token
+		req.IncludeTotalCount = *total
+		req.IncludeDeleted = *deleted
 		resp, err := client.GetFacilities(mctx, &req)
 		printResponse(resp, err)
 
@@ -586,8 +741,17 @@ func main() {
 		resp, err := client.GetFacilityWrapper(mctx, &req)
 		printResponse(resp, err)
 
+	case "clone_facility":
+		req := pb.CloneFacilityRequest{}
+		req.FacilityId = *id
+		req.FacilityName = *name
+		req.IncludeItems = *items
+		resp, err := client.CloneFacility(mctx, &req)
+		printResponse(resp, err)
+
 	case "create_subarea_type":
 		req := pb.CreateSubareaTypeRequest{}
+		req.RequestId = *request_id
 		req.SubareaTypeId = int32(*id)
 		req.SubareaTypeName = *name
 		resp, err := client.CreateSubareaType(mctx, &req)
@@ -605,6 +769,7 @@ func main() {
 		req := pb.DeleteSubareaTypeRequest{}
 		req.SubareaTypeId = int32(*id)
 		req.Version = int32(*version)
+		req.ReassignTo = int32(*reassign_to)
 		resp, err := client.DeleteSubareaType(mctx, &req)
 		printResponse(resp, err)
 
@@ -621,6 +786,7 @@ func main() {
 
 	case "create_item_type":
 		req := pb.CreateItemTypeRequest{}
+		req.RequestId = *request_id
 		req.ItemTypeId = int32(*id)
 		req.ItemTypeName = *name
 		resp, err := client.CreateItemType(mctx, &req)
@@ -638,6 +804,7 @@ func main() {
 		req := pb.DeleteItemTypeRequest{}
 		req.ItemTypeId = int32(*id)
 		req.Version = int32(*version)
+		req.ReassignTo = int32(*reassign_to)
 		resp, err := client.DeleteItemType(mctx, &req)
 		printResponse(resp, err)
 
@@ -652,14 +819,54 @@ func main() {
 		resp, err := client.GetItemTypes(mctx, &req)
 		printResponse(resp, err)
 
+	case "add_subarea_type_nesting":
+		req := pb.AddSubareaTypeNestingRequest{}
+		req.ParentSubareaTypeId = int32(*subtype)
+		req.ChildSubareaTypeId = int32(*childtype)
+		resp, err := client.AddSubareaTypeNesting(mctx, &req)
+		printResponse(resp, err)
+
+	case "remove_subarea_type_nesting":
+		req := pb.RemoveSubareaTypeNestingRequest{}
+		req.ParentSubareaTypeId = int32(*subtype)
+		req.ChildSubareaTypeId = int32(*childtype)
+		resp, err := client.RemoveSubareaTypeNesting(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_subarea_type_nestings":
+		req := pb.GetSubareaTypeNestingsRequest{}
+		resp, err := client.GetSubareaTypeNestings(mctx, &req)
+		printResponse(resp, err)
+
+	case "add_subarea_item_type":
+		req := pb.AddSubareaItemTypeRequest{}
+		req.SubareaTypeId = int32(*subtype)
+		req.ItemTypeId = int32(*itemtype)
+		resp, err := client.AddSubareaItemType(mctx, &req)
+		printResponse(resp, err)
+
+	case "remove_subarea_item_type":
+		req := pb.RemoveSubareaItemTypeRequest{}
+		req.SubareaTypeId = int32(*subtype)
+		req.ItemTypeId = int32(*itemtype)
+		resp, err := client.RemoveSubareaItemType(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_subarea_item_types":
+		req := pb.GetSubareaItemTypesRequest{}
+		resp, err := client.GetSubareaItemTypes(mctx, &req)
+		printResponse(resp, err)
+
 	case "create_subarea":
 		req := pb.CreateSubareaRequest{}
+		req.RequestId = *request_id
 		req.FacilityId = *facility
 		req.ParentSubareaId = *parent
 		req.Position = int32(*position)
 		req.SubareaTypeId = int32(*subtype)
 		req.SubareaName = *name
 		req.JsonData = *json_data
+		req.AutoPosition = *auto
 		resp, err := client.CreateSubarea(mctx, &req)
 		printResponse(resp, err)
 
@@ -672,9 +879,19 @@ func main() {
 		req.SubareaTypeId = int32(*subtype)
 		req.SubareaName = *name
 		req.JsonData = *json_data
+		req.AutoPosition = *auto
+		req.UpdateMask = flagUpdateMask(subareaUpdateFields)
 		resp, err := client.UpdateSubarea(mctx, &req)
 		printResponse(resp, err)
 
+	case "reorder_subareas":
+		req := pb.ReorderSubareasRequest{}
+		req.FacilityId = *facility
+		req.ParentSubareaId = *parent
+		req.SubareaIds = parseIdList(*ids)
+		resp, err := client.ReorderSubareas(mctx, &req)
+		printResponse(resp, err)
+
 	case "delete_subarea":
 		req := pb.DeleteSubareaRequest{}
 		req.SubareaId = *id
@@ -682,20 +899,62 @@ func main() {
 		resp, err := client.DeleteSubarea(mctx, &req)
 		printResponse(resp, err)
 
+	case "undelete_subarea":
+		req := pb.UndeleteSubareaRequest{}
+		req.SubareaId = *id
+		req.Version = int32(*version)
+		resp, err := client.UndeleteSubarea(mctx, &req)
+		printResponse(resp, err)
+
 	case "get_subarea":
 		req := pb.GetSubareaRequest{}
 		req.SubareaId = *id
+		req.IncludeDeleted = *deleted
 		resp, err := client.GetSubarea(mctx, &req)
 		printResponse(resp, err)
 
 	case "get_subareas":
 		req := pb.GetSubareasRequest{}
 		req.FacilityId = *facility
+		req.PageSize = int32(*page_size)
+		req.PageToken = *page_token
+		req.IncludeTotalCount = *total
+		req.IncludeDeleted = *deleted
 		resp, err := client.GetSubareas(mctx, &req)
 		printResponse(resp, err)
 
+	case "get_subareas_by_ids":
+		req := pb.GetSubareasByIdsRequest{}
+		req.SubareaIds = parseIdList(*ids)
+		resp, err := client.GetSubareasByIds(mctx, &req)
+		printResponse(resp, err)
+
+	case "stream_subareas":
+		req := pb.StreamSubareasRequest{}
+		req.FacilityId = *facility
+		stream, err := client.StreamSubareas(mctx, &req)
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			break
+		}
+		printStream(func() (interface{}, error) { return stream.Recv() })
+
+	case "apply_template":
+		template, err := loadSubareaTemplate(*file)
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			break
+		}
+		req := pb.InstantiateTemplateRequest{}
+		req.FacilityId = *facility
+		req.ParentSubareaId = *parent
+		req.SubareaTemplate = template
+		resp, err := client.InstantiateTemplate(mctx, &req)
+		printResponse(resp, err)
+
 	case "create_product":
 		req := pb.CreateProductRequest{}
+		req.RequestId = *request_id
 		req.Sku = *sku
 		req.ProductName = *name
 		req.Comment = *comment
@@ -711,6 +970,7 @@ func main() {
 		req.ProductName = *name
 		req.Comment = *comment
 		req.JsonData = *json_data
+		req.UpdateMask = flagUpdateMask(productUpdateFields)
 		resp, err := client.UpdateProduct(mctx, &req)
 		printResponse(resp, err)
 
@@ -718,22 +978,63 @@ func main() {
 		req := pb.DeleteProductRequest{}
 		req.ProductId = *id
 		req.Version = int32(*version)
+		req.ReassignTo = *reassign_to
 		resp, err := client.DeleteProduct(mctx, &req)
 		printResponse(resp, err)
 
+	case "undelete_product":
+		req := pb.UndeleteProductRequest{}
+		req.ProductId = *id
+		req.Version = int32(*version)
+		resp, err := client.UndeleteProduct(mctx, &req)
+		printResponse(resp, err)
+
 	case "get_product":
 		req := pb.GetProductRequest{}
 		req.ProductId = *id
+		req.IncludeDeleted = *deleted
 		resp, err := client.GetProduct(mctx, &req)
 		printResponse(resp, err)
 
 	case "get_products":
 		req := pb.GetProductsRequest{}
+		req.PageSize = int32(*page_size)
+		req.PageToken = *page_token
+		req.IncludeTotalCount = *total
+		req.IncludeDeleted = *deleted
+		req.Filter = *filter
+		req.OrderBy = *order_by
 		resp, err := client.GetProducts(mctx, &req)
 		printResponse(resp, err)
 
+	case "get_products_by_ids":
+		req := pb.GetProductsByIdsRequest{}
+		req.ProductIds = parseIdList(*ids)
+		resp, err := client.GetProductsByIds(mctx, &req)
+		printResponse(resp, err)
+
+	case "stream_products":
+		req := pb.StreamProductsRequest{}
+		req.Filter = *filter
+		req.OrderBy = *order_by
+		stream, err := client.StreamProducts(mctx, &req)
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			break
+		}
+		printStream(func() (interface{}, error) { return stream.Recv() })
+
+	case "search_products":
+		req := pb.SearchProductsRequest{}
+		req.Query = *query
+		req.PageSize = int32(*page_size)
+		req.PageToken = *page_token
+		resp, err := client.SearchProducts(mctx, &req)
+		printResponse(resp, err)
+
 	case "create_item":
 		req := pb.CreateInventoryItemRequest{}
+		req.RequestId = *request_id
 		req.SubareaId = *subarea
 		req.ItemTypeId = int32(*itemtype)
 		req.Quantity = int32(*quantity)
@@ -753,6 +1054,7 @@ func main() {
 		req.SerialNumber = *serial
 		req.ProductId = *product
 		req.JsonData = *json_data
+		req.UpdateMask = flagUpdateMask(itemUpdateFields)
 		resp, err := client.UpdateInventoryItem(mctx, &req)
 		printResponse(resp, err)
 
@@ -763,32 +1065,103 @@ func main() {
 		resp, err := client.DeleteInventoryItem(mctx, &req)
 		printResponse(resp, err)
 
+	case "undelete_item":
+		req := pb.UndeleteInventoryItemRequest{}
+		req.InventoryItemId = *id
+		req.Version = int32(*version)
+		resp, err := client.UndeleteInventoryItem(mctx, &req)
+		printResponse(resp, err)
+
 	case "get_item":
 		req := pb.GetInventoryItemRequest{}
 		req.InventoryItemId = *id
+		req.IncludeDeleted = *deleted
 		resp, err := client.GetInventoryItem(mctx, &req)
 		printResponse(resp, err)
 
 	case "get_items_by_product":
 		req := pb.GetInventoryItemsByProductRequest{}
 		req.ProductId = *product
+		req.PageSize = int32(*page_size)
+		req.PageToken = *page_token
+		req.IncludeTotalCount = *total
+		req.IncludeDeleted = *deleted
+		req.Filter = *filter
+		req.OrderBy = *order_by
 		resp, err := client.GetInventoryItemsByProduct(mctx, &req)
 		printResponse(resp, err)
 
 	case "get_items_by_subarea":
 		req := pb.GetInventoryItemsBySubareaRequest{}
 		req.SubareaId = *subarea
+		req.PageSize = int32(*page_size)
+		req.PageToken = *page_token
+		req.IncludeTotalCount = *total
+		req.IncludeDeleted = *deleted
+		req.Filter = *filter
+		req.OrderBy = *order_by
 		resp, err := client.GetInventoryItemsBySubarea(mctx, &req)
 		printResponse(resp, err)
 
 	case "get_items_by_facility":
 		req := pb.GetInventoryItemsByFacilityRequest{}
 		req.FacilityId = *facility
+		req.PageSize = int32(*page_size)
+		req.PageToken = *page_token
+		req.IncludeTotalCount = *total
+		req.IncludeDeleted = *deleted
+		req.Filter = *filter
+		req.OrderBy = *order_by
 		resp, err := client.GetInventoryItemsByFacility(mctx, &req)
 		printResponse(resp, err)
 
+	case "get_items_by_ids":
+		req := pb.GetInventoryItemsByIdsRequest{}
+		req.InventoryItemIds = parseIdList(*ids)
+		resp, err := client.GetInventoryItemsByIds(mctx, &req)
+		printResponse(resp, err)
+
+	case "stream_items_by_facility":
+		req := pb.StreamInventoryItemsByFacilityRequest{}
+		req.FacilityId = *facility
+		req.Filter = *filter
+		req.OrderBy = *order_by
+		stream, err := client.StreamInventoryItemsByFacility(mctx, &req)
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			break
+		}
+		printStream(func() (interface{}, error) { return stream.Recv() })
+
+	case "watch_inventory":
+		req := pb.WatchInventoryRequest{}
+		if *facility != -1 {
+			req.FacilityId = *facility
+		}
+		if *subarea != -1 {
+			req.SubareaId = *subarea
+		}
+		if *product != -1 {
+			req.ProductId = *product
+		}
+		req.ResumeToken = *resume_token
+		stream, err := client.WatchInventory(mctx, &req)
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			break
+		}
+		printStream(func() (interface{}, error) { return stream.Recv() })
+
+	case "get_changes":
+		req := pb.GetChangesSinceRequest{}
+		req.Cursor = *cursor
+		req.PageSize = int32(*page_size)
+		resp, err := client.GetChangesSince(mctx, &req)
+		printResponse(resp, err)
+
 	case "create_entity_schema":
 		req := pb.CreateEntitySchemaRequest{}
+		req.RequestId = *request_id
 		req.EntityName = *entity_name
 		req.JsonSchema = *json_data
 		resp, err := client.CreateEntitySchema(mctx, &req)
@@ -813,6 +1186,8 @@ func main() {
 				req2.EntityName = *entity_name
 				req2.Version = resp1.GetEntitySchema().GetVersion()
 				req2.JsonSchema = *json_data
+				req2.CheckExisting = *check_existing
+				req2.Force = *force
 				resp2, err := client.UpdateEntitySchema(mctx, &req2)
 				if err == nil {
 					jtext, err := json.MarshalIndent(resp2, "", "  ")
@@ -890,6 +1265,41 @@ func main() {
 			fmt.Printf("err: %s\n", err)
 		}
 
+	case "create_json_index":
+		req := pb.CreateJsonIndexRequest{}
+		req.RequestId = *request_id
+		req.EntityName = *entity_name
+		req.JsonPath = *json_path
+		req.ValueType = *value_type
+		resp, err := client.CreateJsonIndex(mctx, &req)
+		printResponse(resp, err)
+
+	case "delete_json_index":
+		req := pb.DeleteJsonIndexRequest{}
+		req.EntityName = *entity_name
+		req.JsonPath = *json_path
+		req.ValueType = *value_type
+		resp, err := client.DeleteJsonIndex(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_json_indexes":
+		req := pb.GetJsonIndexesRequest{}
+		resp, err := client.GetJsonIndexes(mctx, &req)
+		printResponse(resp, err)
+
+	case "batch":
+		req := pb.BatchRequest{}
+		buf, err := os.ReadFile(*file)
+		if err == nil {
+			err = protojson.Unmarshal(buf, &req)
+		}
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			break
+		}
+		resp, err := client.Batch(mctx, &req)
+		printResponse(resp, err)
+
 
 	case "get_server_version":
 		req := pb.GetServerVersionRequest{}
@@ -912,3 +1322,144 @@ func printResponse(resp interface{}, err error) {
 		fmt.Printf("err: %s\n", err)
 	}
 }
+
+// Helper to print each message received from a stream as a line of json, until the stream ends.
+func printStream(recv func() (interface{}, error)) {
+	for {
+		resp, err := recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			fmt.Printf("err: %s\n", err)
+			return
+		}
+
+		jtext, err := json.Marshal(resp)
+		if err == nil {
+			fmt.Println(string(jtext))
+		}
+	}
+}
+
+// Helper to build an update mask from the flags given on the command line, so fields not given are left unchanged.
+func flagUpdateMask(fields map[string]string) *fieldmaskpb.FieldMask {
+	mask := &fieldmaskpb.FieldMask{}
+	seen := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		field, ok := fields[f.Name]
+		if ok && !seen[field] {
+			seen[field] = true
+			mask.Paths = append(mask.Paths, field)
+		}
+	})
+
+	return mask
+}
+
+// Helper to parse a validated comma separated list of ids.
+func parseIdList(idlist string) []int64 {
+	idList := make([]int64, 0)
+	for _, field := range strings.Split(idlist, ",") {
+		id, _ := strconv.ParseInt(field, 10, 64)
+		idList = append(idList, id)
+	}
+
+	return idList
+}
+
+// Helper to load a subarea template from a YAML file.
+func loadSubareaTemplate(filename string) (*pb.SubareaTemplate, error) {
+	f, err := yaml.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	root, ok := f.Root.(yaml.Map)
+	if !ok {
+		return nil, fmt.Errorf("%s: template must be a mapping with name and nodes", filename)
+	}
+
+	template := pb.SubareaTemplate{}
+	template.TemplateName = yamlScalar(root, "name")
+	template.Nodes, err = yamlTemplateNodes(root.Key("nodes"), "nodes")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err)
+	}
+
+	return &template, nil
+}
+
+// Helper to convert a YAML list of template nodes, recursing into children.
+func yamlTemplateNodes(node yaml.Node, path string) ([]*pb.SubareaTemplateNode, error) {
+	if node == nil {
+		return nil, nil
+	}
+
+	list, ok := node.(yaml.List)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list", path)
+	}
+
+	nodes := make([]*pb.SubareaTemplateNode, 0, len(list))
+	for i, item := range list {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		entry, ok := item.(yaml.Map)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a mapping", itemPath)
+		}
+
+		tnode := pb.SubareaTemplateNode{}
+		tnode.NamePattern = yamlScalar(entry, "name")
+		tnode.JsonData = yamlScalar(entry, "json_data")
+
+		subtype, err := yamlInt(entry, "subtype")
+		if err != nil {
+			return nil, fmt.Errorf("%s.subtype: %s", itemPath, err)
+		}
+		tnode.SubareaTypeId = int32(subtype)
+
+		count, err := yamlInt(entry, "count")
+		if err != nil {
+			return nil, fmt.Errorf("%s.count: %s", itemPath, err)
+		}
+		tnode.Count = int32(count)
+
+		tnode.Children, err = yamlTemplateNodes(entry.Key("children"), itemPath+".children")
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, &tnode)
+	}
+
+	return nodes, nil
+}
+
+// Helper to get a YAML scalar value by key, without surrounding quotes.
+func yamlScalar(m yaml.Map, key string) string {
+	scalar, ok := m.Key(key).(yaml.Scalar)
+	if !ok {
+		return ""
+	}
+
+	val := strings.TrimSpace(scalar.String())
+	if len(val) >= 2 {
+		first, last := val[0], val[len(val)-1]
+		if (first == '"' && last == '"') || (first == '\'' && last == '\'') {
+			val = val[1 : len(val)-1]
+		}
+	}
+
+	return val
+}
+
+// Helper to get a YAML scalar value by key as an integer, zero if missing.
+func yamlInt(m yaml.Map, key string) (int64, error) {
+	val := yamlScalar(m, key)
+	if val == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(val, 10, 32)
+}
@@ -16,6 +16,8 @@ package main
 
 import (
 	"context"
+	"errors"
+	"expvar"
 	"fmt"
 	"io"
 	"net"
@@ -57,6 +59,27 @@ func main() {
 		RunE:    cli.run,
 	}
 
+	purgeCmd := &cobra.Command{
+		Use:     "purge",
+		Short:   "Hard delete rows soft deleted longer than purge_retention ago, then exit.",
+		PreRunE: cli.setupConfig,
+		RunE:    cli.purge,
+	}
+
+	purgeCmd.Flags().Bool("dry_run", false, "Only report the rows that would be purged.")
+	cmd.AddCommand(purgeCmd)
+
+	jsonIndexCmd := &cobra.Command{
+		Use:     "json_index_column <entity_name> <json_path> <value_type>",
+		Short:   "Add the generated column and index for a json_data path, or drop an unused one, then exit.",
+		Args:    cobra.ExactArgs(3),
+		PreRunE: cli.setupConfig,
+		RunE:    cli.jsonIndexColumn,
+	}
+
+	jsonIndexCmd.Flags().Bool("drop", false, "Drop the column, once no account indexes the path.")
+	cmd.AddCommand(jsonIndexCmd)
+
 	if err := setupFlags(cmd); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -82,29 +105,47 @@ type cfg struct {
 	Tls         bool
 	Port        int
 	RestPort    int
+	MetricsPort int
 	DbUser      string
 	DbPwd       string
 	DbTransport string
 	JwtPubFile  string
 	CorsOrigin  string
+
+	PurgeRetention time.Duration
+	PurgeInterval  time.Duration
+	PurgeBatchSize int
+
+	IdempotencyWindow time.Duration
+
+	GrpcStatusCodes bool
+
+	JsonIndexDdl bool
 }
 
 func setupFlags(cmd *cobra.Command) error {
 
-	cmd.Flags().String("conf", "conf.yaml", "Path to inventory config file.")
-	cmd.Flags().String("log_file", "", "Path to log file.")
-	cmd.Flags().String("cert_file", "", "Path to certificate file.")
-	cmd.Flags().String("key_file", "", "Path to certificate key file.")
-	cmd.Flags().Bool("tls", false, "Use tls for connection.")
-	cmd.Flags().Int("port", 50055, "Port for RPC connections")
-	cmd.Flags().Int("rest_port", 0, "Port for REST connections")
-	cmd.Flags().String("db_user", "", "Database user name.")
-	cmd.Flags().String("db_pwd", "", "Database user password.")
-	cmd.Flags().String("db_transport", "", "Database transport string.")
-	cmd.Flags().String("jwt_pub_file", "", "Path to JWT public certificate.")
-	cmd.Flags().String("cors_origin", "", "Cross origin sites for REST.")
-
-	return viper.BindPFlags(cmd.Flags())
+	cmd.PersistentFlags().String("conf", "conf.yaml", "Path to inventory config file.")
+	cmd.PersistentFlags().String("log_file", "", "Path to log file.")
+	cmd.PersistentFlags().String("cert_file", "", "Path to certificate file.")
+	cmd.PersistentFlags().String("key_file", "", "Path to certificate key file.")
+	cmd.PersistentFlags().Bool("tls", false, "Use tls for connection.")
+	cmd.PersistentFlags().Int("port", 50055, "Port for RPC connections")
+	cmd.PersistentFlags().Int("rest_port", 0, "Port for REST connections")
+	cmd.PersistentFlags().Int("metrics_port", 0, "Port serving expvar metrics at /debug/vars, zero for none.")
+	cmd.PersistentFlags().String("db_user", "", "Database user name.")
+	cmd.PersistentFlags().String("db_pwd", "", "Database user password.")
+	cmd.PersistentFlags().String("db_transport", "", "Database transport string.")
+	cmd.PersistentFlags().String("jwt_pub_file", "", "Path to JWT public certificate.")
+	cmd.PersistentFlags().String("cors_origin", "", "Cross origin sites for REST.")
+	cmd.PersistentFlags().Duration("purge_retention", 0, "Purge rows deleted longer ago than this, zero to keep them.")
+	cmd.PersistentFlags().Duration("purge_interval", 24*time.Hour, "Interval between purges by the server.")
+	cmd.PersistentFlags().Int("purge_batch_size", invservice.DefaultPurgeBatchSize, "Rows removed per purge transaction.")
+	cmd.PersistentFlags().Duration("idempotency_window", invservice.DefaultIdempotencyWindow, "Time a create request_id is remembered.")
+	cmd.PersistentFlags().Bool("grpc_status_codes", false, "Return method errors as gRPC status codes.")
+	cmd.PersistentFlags().Bool("json_index_ddl", false, "Let create_json_index add json index columns to shared tables.")
+
+	return viper.BindPFlags(cmd.PersistentFlags())
 }
 
 func (c *cli) setupConfig(cmd *cobra.Command, args []string) error {
@@ -131,11 +172,18 @@ func (c *cli) setupConfig(cmd *cobra.Command, args []string) error {
 	c.cfg.Tls = viper.GetBool("tls")
 	c.cfg.Port = viper.GetInt("port")
 	c.cfg.RestPort = viper.GetInt("rest-port")
+	c.cfg.MetricsPort = viper.GetInt("metrics_port")
 	c.cfg.DbUser = viper.GetString("db_user")
 	c.cfg.DbPwd = viper.GetString("db_pwd")
 	c.cfg.DbTransport = viper.GetString("db_transport")
 	c.cfg.JwtPubFile = viper.GetString("jwt_pub_file")
 	c.cfg.CorsOrigin = viper.GetString("cors_origin")
+	c.cfg.PurgeRetention = viper.GetDuration("purge_retention")
+	c.cfg.PurgeInterval = viper.GetDuration("purge_interval")
+	c.cfg.PurgeBatchSize = viper.GetInt("purge_batch_size")
+	c.cfg.IdempotencyWindow = viper.GetDuration("idempotency_window")
+	c.cfg.GrpcStatusCodes = viper.GetBool("grpc_status_codes")
+	c.cfg.JsonIndexDdl = viper.GetBool("json_index_ddl")
 
 	return nil
 }
@@ -173,11 +221,16 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 	level.Info(logger).Log("tls", tls)
 	level.Info(logger).Log("port", port)
 	level.Info(logger).Log("rest_port", rest_port)
+	level.Info(logger).Log("metrics_port", c.cfg.MetricsPort)
 
 	level.Info(logger).Log("db_user", db_user)
 	level.Info(logger).Log("db_transport", db_transport)
 	level.Info(logger).Log("jwt_pub_file", jwt_pub_file)
 	level.Info(logger).Log("cors_origin", cors_origin)
+	level.Info(logger).Log("purge_retention", c.cfg.PurgeRetention)
+	level.Info(logger).Log("idempotency_window", c.cfg.IdempotencyWindow)
+	level.Info(logger).Log("grpc_status_codes", c.cfg.GrpcStatusCodes)
+	level.Info(logger).Log("json_index_ddl", c.cfg.JsonIndexDdl)
 
 	listen_port := ":" + strconv.Itoa(int(port))
 	// fmt.Println(listen_port)
@@ -197,8 +250,6 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 		opts = []grpc.ServerOption{grpc.Creds(creds)}
 	}
 
-	s := grpc.NewServer(opts...)
-
 	invService := invservice.NewInvService()
 
 	sqlDb, err := SetupDatabaseConnections(db_user, db_pwd, db_transport)
@@ -209,6 +260,24 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 
 	invService.SetLogger(logger)
 	invService.SetDatabaseConnection(sqlDb)
+	invService.SetIdempotencyWindow(c.cfg.IdempotencyWindow)
+	invService.SetPurgeRetention(c.cfg.PurgeRetention)
+	invService.SetJsonIndexDdl(c.cfg.JsonIndexDdl)
+
+	invalidSchemas, err := invService.CheckStoredSchemas()
+	if err != nil {
+		level.Error(logger).Log("what", "CheckStoredSchemas", "error", err)
+	} else if invalidSchemas > 0 {
+		level.Warn(logger).Log("msg", "some stored entity schemas are invalid and not enforced until updated",
+			"invalid_schemas", invalidSchemas)
+	}
+
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+
+	if (c.cfg.PurgeRetention > 0) && (c.cfg.PurgeInterval > 0) {
+		go invService.RunPurgeJob(purgeCtx, c.cfg.PurgeRetention, c.cfg.PurgeInterval, c.cfg.PurgeBatchSize)
+	}
 
 	// wire up the authorization middleware
 
@@ -218,6 +287,14 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 
 	invAuth.SetPublicKey(jwt_pub_file)
 	invAuth.SetDatabaseConnection(sqlDb)
+
+	if c.cfg.GrpcStatusCodes {
+		opts = append(opts, grpc.ChainUnaryInterceptor(invAuth.StatusUnaryInterceptor),
+			grpc.ChainStreamInterceptor(invAuth.StatusStreamInterceptor))
+	}
+
+	s := grpc.NewServer(opts...)
+
 	err = invAuth.NewApiServer(s)
 	if err != nil {
 		level.Error(logger).Log("what", "NewApiServer", "error", err)
@@ -237,7 +314,7 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 			c := cors.New(cors.Options{
 				AllowedOrigins:   origins,
 				AllowCredentials: true,
-				AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE"},
+				AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
 				AllowedHeaders:   []string{"*"},
 				// Debug: true,
 			})
@@ -268,6 +345,28 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 		}()
 	}
 
+	var metricsSrv *http.Server
+
+	if c.cfg.MetricsPort > 0 {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/debug/vars", expvar.Handler())
+
+		metricsSrv = &http.Server{
+			Addr:         fmt.Sprintf(":%d", c.cfg.MetricsPort),
+			WriteTimeout: time.Second * 15,
+			ReadTimeout:  time.Second * 15,
+			Handler:      metricsMux,
+		}
+
+		go func() {
+			level.Info(logger).Log("msg", "starting metrics server")
+			err := metricsSrv.ListenAndServe()
+			if err != http.ErrServerClosed {
+				level.Error(logger).Log("what", "ListenAndServe", "error", err)
+			}
+		}()
+	}
+
 	go func() {
 		level.Info(logger).Log("msg", "starting grpc server")
 
@@ -285,6 +384,8 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 	// Block until we receive our signal.
 	<-ch
 
+	stopPurge()
+
 	s.GracefulStop()
 	level.Info(logger).Log("msg", "shutting down grpc server")
 
@@ -301,11 +402,60 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 		level.Info(logger).Log("msg", "shutting down http server")
 	}
 
+	if metricsSrv != nil {
+		metricsSrv.Close()
+		level.Info(logger).Log("msg", "shutting down metrics server")
+	}
+
 	// os.Exit(0)
 
 	return nil
 }
 
+// Run one purge of soft-deleted rows and print the rows removed from each table.
+func (c *cli) purge(cmd *cobra.Command, args []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry_run")
+
+	if c.cfg.PurgeRetention <= 0 {
+		return errors.New("purge_retention must be set to a positive duration, such as 2160h")
+	}
+
+	logger := log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
+	logger = log.With(logger, "ts", log.DefaultTimestampUTC, "caller", log.DefaultCaller)
+
+	sqlDb, err := SetupDatabaseConnections(c.cfg.DbUser, c.cfg.DbPwd, c.cfg.DbTransport)
+	if err != nil {
+		return err
+	}
+
+	defer sqlDb.Close()
+
+	invService := invservice.NewInvService()
+	invService.SetLogger(logger)
+	invService.SetDatabaseConnection(sqlDb)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	report, err := invService.Purge(ctx, c.cfg.PurgeRetention, c.cfg.PurgeBatchSize, dryRun)
+
+	verb := "purged"
+	if dryRun {
+		verb = "purgeable"
+	}
+
+	for _, count := range report.Tables {
+		fmt.Printf("%-24s %8d rows %s in %d batches, %s\n", count.Table, count.Rows, verb, count.Batches,
+			count.Duration.Round(time.Millisecond))
+	}
+
+	if err == nil {
+		fmt.Printf("done in %s\n", report.Duration.Round(time.Millisecond))
+	}
+
+	return err
+}
+
 func SetupDatabaseConnections(db_user string, db_pwd string, db_transport string) (*sql.DB, error) {
 	var sqlDb *sql.DB
 	endpoint := db_user + ":" + db_pwd + "@" + db_transport + "/inventory"
@@ -322,3 +472,31 @@ func SetupDatabaseConnections(db_user string, db_pwd string, db_transport string
 
 	return sqlDb, err
 }
+
+// Add or drop the generated column and index for a json_data path and print what was done.
+func (c *cli) jsonIndexColumn(cmd *cobra.Command, args []string) error {
+	drop, _ := cmd.Flags().GetBool("drop")
+
+	logger := log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
+	logger = log.With(logger, "ts", log.DefaultTimestampUTC, "caller", log.DefaultCaller)
+
+	sqlDb, err := SetupDatabaseConnections(c.cfg.DbUser, c.cfg.DbPwd, c.cfg.DbTransport)
+	if err != nil {
+		return err
+	}
+
+	defer sqlDb.Close()
+
+	invService := invservice.NewInvService()
+	invService.SetLogger(logger)
+	invService.SetDatabaseConnection(sqlDb)
+
+	column, done, err := invService.JsonIndexColumn(context.Background(), args[0], args[1], args[2], drop)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s %s\n", column, done)
+
+	return nil
+}
@@ -135,6 +135,25 @@ func (s *InvAuth) GetJwtFromContext(ctx context.Context) (*map[string]interface{
 
 }
 
+// Get the idempotency key of a create request, from the request_id field or else the idempotency-key metadata.
+func GetRequestIdFromContext(ctx context.Context, requestId string) string {
+	if requestId != "" {
+		return requestId
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	keys := md["idempotency-key"]
+	if len(keys) == 0 {
+		return ""
+	}
+
+	return keys[0]
+}
+
 // Helper to see if JWT provides admin access.
 func HasAdminAccess(claims *map[string]interface{}) bool {
 	invsvc := GetStringFromClaims(claims, "invsvc")
@@ -204,6 +223,7 @@ func (s *InvAuth) CreateFacility(ctx context.Context, req *pb.CreateFacilityRequ
 	if err == nil {
 		if HasAdminAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			req.RequestId = GetRequestIdFromContext(ctx, req.GetRequestId())
 			resp, err = s.invService.CreateFacility(ctx, req)
 		}
 	} else {
@@ -283,6 +303,36 @@ func (s *InvAuth) DeleteFacility(ctx context.Context, req *pb.DeleteFacilityRequ
 	return resp, err
 }
 
+// restore a deleted facility
+func (s *InvAuth) UndeleteFacility(ctx context.Context, req *pb.UndeleteFacilityRequest) (*pb.UndeleteFacilityResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.UndeleteFacilityResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasAdminAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.UndeleteFacility(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UndeleteFacility",
+		"facilityid", req.GetFacilityId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
 // get a facility by id
 func (s *InvAuth) GetFacility(ctx context.Context, req *pb.GetFacilityRequest) (*pb.GetFacilityResponse, error) {
 	start := time.Now().UnixNano()
@@ -373,6 +423,38 @@ func (s *InvAuth) GetFacilityWrapper(ctx context.Context, req *pb.GetFacilityWra
 	return resp, err
 }
 
+// copy a facility and its subarea hierarchy to a new facility
+func (s *InvAuth) CloneFacility(ctx context.Context, req *pb.CloneFacilityRequest) (*pb.CloneFacilityResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.CloneFacilityResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasAdminAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.CloneFacility(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CloneFacility",
+		"facilityid", req.GetFacilityId(),
+		"facility", req.GetFacilityName(),
+		"items", req.GetIncludeItems(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
 // create new subarea type
 func (s *InvAuth) CreateSubareaType(ctx context.Context, req *pb.CreateSubareaTypeRequest) (*pb.CreateSubareaTypeResponse, error) {
 	start := time.Now().UnixNano()
@@ -384,6 +466,7 @@ func (s *InvAuth) CreateSubareaType(ctx context.Context, req *pb.CreateSubareaTy
 	if err == nil {
 		if HasRWAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			req.RequestId = GetRequestIdFromContext(ctx, req.GetRequestId())
 			resp, err = s.invService.CreateSubareaType(ctx, req)
 		}
 	} else {
@@ -442,7 +525,7 @@ func (s *InvAuth) DeleteSubareaType(ctx context.Context, req *pb.DeleteSubareaTy
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasRWAccess(claims) {
+		if HasRWAccess(claims) && ((req.GetReassignTo() == 0) || HasAdminAccess(claims)) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
 			resp, err = s.invService.DeleteSubareaType(ctx, req)
 		}
@@ -458,6 +541,7 @@ func (s *InvAuth) DeleteSubareaType(ctx context.Context, req *pb.DeleteSubareaTy
 	duration := time.Now().UnixNano() - start
 	level.Info(s.logger).Log("endpoint", "DeleteSubareaType",
 		"subareatypeid", req.GetSubareaTypeId(),
+		"reassignto", req.GetReassignTo(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
@@ -534,6 +618,7 @@ func (s *InvAuth) CreateItemType(ctx context.Context, req *pb.CreateItemTypeRequ
 	if err == nil {
 		if HasRWAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			req.RequestId = GetRequestIdFromContext(ctx, req.GetRequestId())
 			resp, err = s.invService.CreateItemType(ctx, req)
 		}
 	} else {
@@ -592,7 +677,7 @@ func (s *InvAuth) DeleteItemType(ctx context.Context, req *pb.DeleteItemTypeRequ
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasRWAccess(claims) {
+		if HasRWAccess(claims) && ((req.GetReassignTo() == 0) || HasAdminAccess(claims)) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
 			resp, err = s.invService.DeleteItemType(ctx, req)
 		}
@@ -608,6 +693,7 @@ func (s *InvAuth) DeleteItemType(ctx context.Context, req *pb.DeleteItemTypeRequ
 	duration := time.Now().UnixNano() - start
 	level.Info(s.logger).Log("endpoint", "DeleteItemType",
 		"itemtypeid", req.GetItemTypeId(),
+		"reassignto", req.GetReassignTo(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
@@ -673,18 +759,18 @@ func (s *InvAuth) GetItemTypes(ctx context.Context, req *pb.GetItemTypesRequest)
 	return resp, err
 }
 
-// create a new subarea
-func (s *InvAuth) CreateSubarea(ctx context.Context, req *pb.CreateSubareaRequest) (*pb.CreateSubareaResponse, error) {
+// allow a subarea type to be nested in a parent subarea type
+func (s *InvAuth) AddSubareaTypeNesting(ctx context.Context, req *pb.AddSubareaTypeNestingRequest) (*pb.AddSubareaTypeNestingResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.CreateSubareaResponse{}
+	resp := &pb.AddSubareaTypeNestingResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasRWAccess(claims) {
+		if HasAdminAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.CreateSubarea(ctx, req)
+			resp, err = s.invService.AddSubareaTypeNesting(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -696,25 +782,26 @@ func (s *InvAuth) CreateSubarea(ctx context.Context, req *pb.CreateSubareaReques
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "CreateSubarea",
-		"subarea", req.GetSubareaName(),
+	level.Info(s.logger).Log("endpoint", "AddSubareaTypeNesting",
+		"parenttype", req.GetParentSubareaTypeId(),
+		"childtype", req.GetChildSubareaTypeId(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// update an existing subarea
-func (s *InvAuth) UpdateSubarea(ctx context.Context, req *pb.UpdateSubareaRequest) (*pb.UpdateSubareaResponse, error) {
+// remove a subarea type nesting rule
+func (s *InvAuth) RemoveSubareaTypeNesting(ctx context.Context, req *pb.RemoveSubareaTypeNestingRequest) (*pb.RemoveSubareaTypeNestingResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.UpdateSubareaResponse{}
+	resp := &pb.RemoveSubareaTypeNestingResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasRWAccess(claims) {
+		if HasAdminAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.UpdateSubarea(ctx, req)
+			resp, err = s.invService.RemoveSubareaTypeNesting(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -726,25 +813,26 @@ func (s *InvAuth) UpdateSubarea(ctx context.Context, req *pb.UpdateSubareaReques
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "UpdateSubarea",
-		"subarea", req.GetSubareaName(),
+	level.Info(s.logger).Log("endpoint", "RemoveSubareaTypeNesting",
+		"parenttype", req.GetParentSubareaTypeId(),
+		"childtype", req.GetChildSubareaTypeId(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// delete an existing subarea
-func (s *InvAuth) DeleteSubarea(ctx context.Context, req *pb.DeleteSubareaRequest) (*pb.DeleteSubareaResponse, error) {
+// get all subarea type nesting rules by mservice_id
+func (s *InvAuth) GetSubareaTypeNestings(ctx context.Context, req *pb.GetSubareaTypeNestingsRequest) (*pb.GetSubareaTypeNestingsResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.DeleteSubareaResponse{}
+	resp := &pb.GetSubareaTypeNestingsResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasRWAccess(claims) {
+		if HasReadAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.DeleteSubarea(ctx, req)
+			resp, err = s.invService.GetSubareaTypeNestings(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -756,25 +844,24 @@ func (s *InvAuth) DeleteSubarea(ctx context.Context, req *pb.DeleteSubareaReques
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "DeleteSubarea",
-		"subareaid", req.GetSubareaId(),
+	level.Info(s.logger).Log("endpoint", "GetSubareaTypeNestings",
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// get a subarea by id
-func (s *InvAuth) GetSubarea(ctx context.Context, req *pb.GetSubareaRequest) (*pb.GetSubareaResponse, error) {
+// allow an item type to be stored in a subarea type
+func (s *InvAuth) AddSubareaItemType(ctx context.Context, req *pb.AddSubareaItemTypeRequest) (*pb.AddSubareaItemTypeResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.GetSubareaResponse{}
+	resp := &pb.AddSubareaItemTypeResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasReadAccess(claims) {
+		if HasAdminAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.GetSubarea(ctx, req)
+			resp, err = s.invService.AddSubareaItemType(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -786,25 +873,26 @@ func (s *InvAuth) GetSubarea(ctx context.Context, req *pb.GetSubareaRequest) (*p
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetSubarea",
-		"subareaid", req.GetSubareaId(),
+	level.Info(s.logger).Log("endpoint", "AddSubareaItemType",
+		"subareatype", req.GetSubareaTypeId(),
+		"itemtype", req.GetItemTypeId(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// get all subareas by facility_id
-func (s *InvAuth) GetSubareas(ctx context.Context, req *pb.GetSubareasRequest) (*pb.GetSubareasResponse, error) {
+// remove a subarea item type rule
+func (s *InvAuth) RemoveSubareaItemType(ctx context.Context, req *pb.RemoveSubareaItemTypeRequest) (*pb.RemoveSubareaItemTypeResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.GetSubareasResponse{}
+	resp := &pb.RemoveSubareaItemTypeResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasReadAccess(claims) {
+		if HasAdminAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.GetSubareas(ctx, req)
+			resp, err = s.invService.RemoveSubareaItemType(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -816,25 +904,26 @@ func (s *InvAuth) GetSubareas(ctx context.Context, req *pb.GetSubareasRequest) (
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetSubareas",
-		"facilityid", req.GetFacilityId(),
+	level.Info(s.logger).Log("endpoint", "RemoveSubareaItemType",
+		"subareatype", req.GetSubareaTypeId(),
+		"itemtype", req.GetItemTypeId(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// create a new product
-func (s *InvAuth) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
+// get all subarea item type rules by mservice_id
+func (s *InvAuth) GetSubareaItemTypes(ctx context.Context, req *pb.GetSubareaItemTypesRequest) (*pb.GetSubareaItemTypesResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.CreateProductResponse{}
+	resp := &pb.GetSubareaItemTypesResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasRWAccess(claims) {
+		if HasReadAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.CreateProduct(ctx, req)
+			resp, err = s.invService.GetSubareaItemTypes(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -846,17 +935,16 @@ func (s *InvAuth) CreateProduct(ctx context.Context, req *pb.CreateProductReques
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "CreateProduct",
-		"product", req.GetProductName(),
+	level.Info(s.logger).Log("endpoint", "GetSubareaItemTypes",
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// update an existing product
-func (s *InvAuth) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
+// create a new subarea
+func (s *InvAuth) CreateSubarea(ctx context.Context, req *pb.CreateSubareaRequest) (*pb.CreateSubareaResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.UpdateProductResponse{}
+	resp := &pb.CreateSubareaResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
@@ -864,7 +952,8 @@ func (s *InvAuth) UpdateProduct(ctx context.Context, req *pb.UpdateProductReques
 	if err == nil {
 		if HasRWAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.UpdateProduct(ctx, req)
+			req.RequestId = GetRequestIdFromContext(ctx, req.GetRequestId())
+			resp, err = s.invService.CreateSubarea(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -876,17 +965,17 @@ func (s *InvAuth) UpdateProduct(ctx context.Context, req *pb.UpdateProductReques
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "UpdateProduct",
-		"product", req.GetProductName(),
+	level.Info(s.logger).Log("endpoint", "CreateSubarea",
+		"subarea", req.GetSubareaName(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// delete an existing product
-func (s *InvAuth) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+// update an existing subarea
+func (s *InvAuth) UpdateSubarea(ctx context.Context, req *pb.UpdateSubareaRequest) (*pb.UpdateSubareaResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.DeleteProductResponse{}
+	resp := &pb.UpdateSubareaResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
@@ -894,7 +983,7 @@ func (s *InvAuth) DeleteProduct(ctx context.Context, req *pb.DeleteProductReques
 	if err == nil {
 		if HasRWAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.DeleteProduct(ctx, req)
+			resp, err = s.invService.UpdateSubarea(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -906,25 +995,25 @@ func (s *InvAuth) DeleteProduct(ctx context.Context, req *pb.DeleteProductReques
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "DeleteProduct",
-		"productid", req.GetProductId(),
+	level.Info(s.logger).Log("endpoint", "UpdateSubarea",
+		"subarea", req.GetSubareaName(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// get a product by id
-func (s *InvAuth) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductResponse, error) {
+// delete an existing subarea
+func (s *InvAuth) DeleteSubarea(ctx context.Context, req *pb.DeleteSubareaRequest) (*pb.DeleteSubareaResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.GetProductResponse{}
+	resp := &pb.DeleteSubareaResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasReadAccess(claims) {
+		if HasRWAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.GetProduct(ctx, req)
+			resp, err = s.invService.DeleteSubarea(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -936,25 +1025,25 @@ func (s *InvAuth) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*p
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetProduct",
-		"productid", req.GetProductId(),
+	level.Info(s.logger).Log("endpoint", "DeleteSubarea",
+		"subareaid", req.GetSubareaId(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// get all products by mservice_id
-func (s *InvAuth) GetProducts(ctx context.Context, req *pb.GetProductsRequest) (*pb.GetProductsResponse, error) {
+// restore a deleted subarea
+func (s *InvAuth) UndeleteSubarea(ctx context.Context, req *pb.UndeleteSubareaRequest) (*pb.UndeleteSubareaResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.GetProductsResponse{}
+	resp := &pb.UndeleteSubareaResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasReadAccess(claims) {
+		if HasAdminAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.GetProducts(ctx, req)
+			resp, err = s.invService.UndeleteSubarea(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -966,25 +1055,25 @@ func (s *InvAuth) GetProducts(ctx context.Context, req *pb.GetProductsRequest) (
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetProducts",
-		"mserviceid", req.GetMserviceId(),
+	level.Info(s.logger).Log("endpoint", "UndeleteSubarea",
+		"subareaid", req.GetSubareaId(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// create a new inventory item
-func (s *InvAuth) CreateInventoryItem(ctx context.Context, req *pb.CreateInventoryItemRequest) (*pb.CreateInventoryItemResponse, error) {
+// get a subarea by id
+func (s *InvAuth) GetSubarea(ctx context.Context, req *pb.GetSubareaRequest) (*pb.GetSubareaResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.CreateInventoryItemResponse{}
+	resp := &pb.GetSubareaResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasRWAccess(claims) {
+		if HasReadAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.CreateInventoryItem(ctx, req)
+			resp, err = s.invService.GetSubarea(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -996,25 +1085,25 @@ func (s *InvAuth) CreateInventoryItem(ctx context.Context, req *pb.CreateInvento
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "CreateInventoryItem",
-		"productid", req.GetProductId(),
+	level.Info(s.logger).Log("endpoint", "GetSubarea",
+		"subareaid", req.GetSubareaId(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// update an existing inventory item
-func (s *InvAuth) UpdateInventoryItem(ctx context.Context, req *pb.UpdateInventoryItemRequest) (*pb.UpdateInventoryItemResponse, error) {
+// get all subareas by facility_id
+func (s *InvAuth) GetSubareas(ctx context.Context, req *pb.GetSubareasRequest) (*pb.GetSubareasResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.UpdateInventoryItemResponse{}
+	resp := &pb.GetSubareasResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasRWAccess(claims) {
+		if HasReadAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.UpdateInventoryItem(ctx, req)
+			resp, err = s.invService.GetSubareas(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -1026,25 +1115,25 @@ func (s *InvAuth) UpdateInventoryItem(ctx context.Context, req *pb.UpdateInvento
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "UpdateInventoryItem",
-		"itemid", req.GetInventoryItemId(),
+	level.Info(s.logger).Log("endpoint", "GetSubareas",
+		"facilityid", req.GetFacilityId(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// delete an existing inventory item
-func (s *InvAuth) DeleteInventoryItem(ctx context.Context, req *pb.DeleteInventoryItemRequest) (*pb.DeleteInventoryItemResponse, error) {
+// get subareas by a list of ids
+func (s *InvAuth) GetSubareasByIds(ctx context.Context, req *pb.GetSubareasByIdsRequest) (*pb.GetSubareasByIdsResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.DeleteInventoryItemResponse{}
+	resp := &pb.GetSubareasByIdsResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasRWAccess(claims) {
+		if HasReadAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.DeleteInventoryItem(ctx, req)
+			resp, err = s.invService.GetSubareasByIds(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -1056,25 +1145,26 @@ func (s *InvAuth) DeleteInventoryItem(ctx context.Context, req *pb.DeleteInvento
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "DeleteInventoryItem",
-		"itemid", req.GetInventoryItemId(),
+	level.Info(s.logger).Log("endpoint", "GetSubareasByIds",
+		"ids", len(req.GetSubareaIds()),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// get an inventory item by id
-func (s *InvAuth) GetInventoryItem(ctx context.Context, req *pb.GetInventoryItemRequest) (*pb.GetInventoryItemResponse, error) {
+// stream all subareas by facility
+func (s *InvAuth) StreamSubareas(req *pb.StreamSubareasRequest, stream pb.MServiceInventory_StreamSubareasServer) error {
 	start := time.Now().UnixNano()
-	resp := &pb.GetInventoryItemResponse{}
+	resp := &pb.StreamSubareasResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
-	claims, err := s.GetJwtFromContext(ctx)
+	claims, err := s.GetJwtFromContext(stream.Context())
 	if err == nil {
 		if HasReadAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.GetInventoryItem(ctx, req)
+			err = s.invService.StreamSubareas(req, stream)
+			resp = nil
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -1085,26 +1175,30 @@ func (s *InvAuth) GetInventoryItem(ctx context.Context, req *pb.GetInventoryItem
 		err = nil
 	}
 
+	if resp != nil {
+		err = stream.Send(resp)
+	}
+
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetInventoryItem",
-		"itemid", req.GetInventoryItemId(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
+	level.Info(s.logger).Log("endpoint", "StreamSubareas",
+		"facilityid", req.GetFacilityId(),
+		"error", err, "duration", duration)
 
-	return resp, err
+	return err
 }
 
-// get all inventory items for a product id
-func (s *InvAuth) GetInventoryItemsByProduct(ctx context.Context, req *pb.GetInventoryItemsByProductRequest) (*pb.GetInventoryItemsByProductResponse, error) {
+// create a tree of subareas from a template
+func (s *InvAuth) InstantiateTemplate(ctx context.Context, req *pb.InstantiateTemplateRequest) (*pb.InstantiateTemplateResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.GetInventoryItemsByProductResponse{}
+	resp := &pb.InstantiateTemplateResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasReadAccess(claims) {
+		if HasRWAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.GetInventoryItemsByProduct(ctx, req)
+			resp, err = s.invService.InstantiateTemplate(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -1116,25 +1210,27 @@ func (s *InvAuth) GetInventoryItemsByProduct(ctx context.Context, req *pb.GetInv
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetInventoryItemsByProduct",
-		"productid", req.GetProductId(),
+	level.Info(s.logger).Log("endpoint", "InstantiateTemplate",
+		"facilityid", req.GetFacilityId(),
+		"parentid", req.GetParentSubareaId(),
+		"template", req.GetSubareaTemplate().GetTemplateName(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// get all inventory items in a subarea
-func (s *InvAuth) GetInventoryItemsBySubarea(ctx context.Context, req *pb.GetInventoryItemsBySubareaRequest) (*pb.GetInventoryItemsBySubareaResponse, error) {
+// rewrite the positions of all children of a parent subarea
+func (s *InvAuth) ReorderSubareas(ctx context.Context, req *pb.ReorderSubareasRequest) (*pb.ReorderSubareasResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.GetInventoryItemsBySubareaResponse{}
+	resp := &pb.ReorderSubareasResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasReadAccess(claims) {
+		if HasRWAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.GetInventoryItemsBySubarea(ctx, req)
+			resp, err = s.invService.ReorderSubareas(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -1146,25 +1242,28 @@ func (s *InvAuth) GetInventoryItemsBySubarea(ctx context.Context, req *pb.GetInv
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetInventoryItemsBySubarea",
-		"subareaid", req.GetSubareaId(),
+	level.Info(s.logger).Log("endpoint", "ReorderSubareas",
+		"facilityid", req.GetFacilityId(),
+		"parentid", req.GetParentSubareaId(),
+		"count", len(req.GetSubareaIds()),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// get all inventory items in a facility
-func (s *InvAuth) GetInventoryItemsByFacility(ctx context.Context, req *pb.GetInventoryItemsByFacilityRequest) (*pb.GetInventoryItemsByFacilityResponse, error) {
+// create a new product
+func (s *InvAuth) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.GetInventoryItemsByFacilityResponse{}
+	resp := &pb.CreateProductResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasReadAccess(claims) {
+		if HasRWAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.GetInventoryItemsByFacility(ctx, req)
+			req.RequestId = GetRequestIdFromContext(ctx, req.GetRequestId())
+			resp, err = s.invService.CreateProduct(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -1176,30 +1275,25 @@ func (s *InvAuth) GetInventoryItemsByFacility(ctx context.Context, req *pb.GetIn
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "GetInventoryItemsByFacility",
-		"facilityid", req.GetFacilityId(),
+	level.Info(s.logger).Log("endpoint", "CreateProduct",
+		"product", req.GetProductName(),
 		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
 
-// get current server version and uptime - health check
-func (s *InvAuth) GetServerVersion(ctx context.Context, req *pb.GetServerVersionRequest) (*pb.GetServerVersionResponse, error) {
-	return s.invService.GetServerVersion(ctx, req)
-}
-
-// create an entity schema
-func (s *InvAuth) CreateEntitySchema(ctx context.Context, req *pb.CreateEntitySchemaRequest) (*pb.CreateEntitySchemaResponse, error) {
+// update an existing product
+func (s *InvAuth) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
 	start := time.Now().UnixNano()
-	resp := &pb.CreateEntitySchemaResponse{}
+	resp := &pb.UpdateProductResponse{}
 	resp.ErrorCode = 401
 	resp.ErrorMessage = "not authorized"
 
 	claims, err := s.GetJwtFromContext(ctx)
 	if err == nil {
-		if HasAdminAccess(claims) {
+		if HasRWAccess(claims) {
 			req.MserviceId = GetInt64FromClaims(claims, "aid")
-			resp, err = s.invService.CreateEntitySchema(ctx, req)
+			resp, err = s.invService.UpdateProduct(ctx, req)
 		}
 	} else {
 		if err.Error() == tokenExpiredMatch {
@@ -1211,9 +1305,632 @@ func (s *InvAuth) CreateEntitySchema(ctx context.Context, req *pb.CreateEntitySc
 	}
 
 	duration := time.Now().UnixNano() - start
-	level.Info(s.logger).Log("endpoint", "CreateEntitySchema",
-		"entity", req.GetEntityName(),
-		"errcode", resp.GetErrorCode(), "duration", duration)
+	level.Info(s.logger).Log("endpoint", "UpdateProduct",
+		"product", req.GetProductName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete an existing product
+func (s *InvAuth) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.DeleteProductResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasRWAccess(claims) && ((req.GetReassignTo() == 0) || HasAdminAccess(claims)) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.DeleteProduct(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteProduct",
+		"productid", req.GetProductId(),
+		"reassignto", req.GetReassignTo(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// restore a deleted product
+func (s *InvAuth) UndeleteProduct(ctx context.Context, req *pb.UndeleteProductRequest) (*pb.UndeleteProductResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.UndeleteProductResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasAdminAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.UndeleteProduct(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UndeleteProduct",
+		"productid", req.GetProductId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get a product by id
+func (s *InvAuth) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.GetProductResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.GetProduct(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetProduct",
+		"productid", req.GetProductId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get all products by mservice_id
+func (s *InvAuth) GetProducts(ctx context.Context, req *pb.GetProductsRequest) (*pb.GetProductsResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.GetProductsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.GetProducts(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetProducts",
+		"mserviceid", req.GetMserviceId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get products by a list of ids
+func (s *InvAuth) GetProductsByIds(ctx context.Context, req *pb.GetProductsByIdsRequest) (*pb.GetProductsByIdsResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.GetProductsByIdsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.GetProductsByIds(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetProductsByIds",
+		"ids", len(req.GetProductIds()),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// stream all products by mservice_id
+func (s *InvAuth) StreamProducts(req *pb.StreamProductsRequest, stream pb.MServiceInventory_StreamProductsServer) error {
+	start := time.Now().UnixNano()
+	resp := &pb.StreamProductsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(stream.Context())
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			err = s.invService.StreamProducts(req, stream)
+			resp = nil
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	if resp != nil {
+		err = stream.Send(resp)
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "StreamProducts",
+		"mserviceid", req.GetMserviceId(),
+		"error", err, "duration", duration)
+
+	return err
+}
+
+// full text search of product name, sku and comment
+func (s *InvAuth) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.SearchProductsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.SearchProducts(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "SearchProducts",
+		"query", req.GetQuery(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create a new inventory item
+func (s *InvAuth) CreateInventoryItem(ctx context.Context, req *pb.CreateInventoryItemRequest) (*pb.CreateInventoryItemResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.CreateInventoryItemResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasRWAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			req.RequestId = GetRequestIdFromContext(ctx, req.GetRequestId())
+			resp, err = s.invService.CreateInventoryItem(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateInventoryItem",
+		"productid", req.GetProductId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update an existing inventory item
+func (s *InvAuth) UpdateInventoryItem(ctx context.Context, req *pb.UpdateInventoryItemRequest) (*pb.UpdateInventoryItemResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.UpdateInventoryItemResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasRWAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.UpdateInventoryItem(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateInventoryItem",
+		"itemid", req.GetInventoryItemId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete an existing inventory item
+func (s *InvAuth) DeleteInventoryItem(ctx context.Context, req *pb.DeleteInventoryItemRequest) (*pb.DeleteInventoryItemResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.DeleteInventoryItemResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasRWAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.DeleteInventoryItem(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteInventoryItem",
+		"itemid", req.GetInventoryItemId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// restore a deleted inventory item
+func (s *InvAuth) UndeleteInventoryItem(ctx context.Context, req *pb.UndeleteInventoryItemRequest) (*pb.UndeleteInventoryItemResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.UndeleteInventoryItemResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasAdminAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.UndeleteInventoryItem(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UndeleteInventoryItem",
+		"itemid", req.GetInventoryItemId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get an inventory item by id
+func (s *InvAuth) GetInventoryItem(ctx context.Context, req *pb.GetInventoryItemRequest) (*pb.GetInventoryItemResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.GetInventoryItemResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.GetInventoryItem(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetInventoryItem",
+		"itemid", req.GetInventoryItemId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get all inventory items for a product id
+func (s *InvAuth) GetInventoryItemsByProduct(ctx context.Context, req *pb.GetInventoryItemsByProductRequest) (*pb.GetInventoryItemsByProductResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.GetInventoryItemsByProductResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.GetInventoryItemsByProduct(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetInventoryItemsByProduct",
+		"productid", req.GetProductId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get all inventory items in a subarea
+func (s *InvAuth) GetInventoryItemsBySubarea(ctx context.Context, req *pb.GetInventoryItemsBySubareaRequest) (*pb.GetInventoryItemsBySubareaResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.GetInventoryItemsBySubareaResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.GetInventoryItemsBySubarea(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetInventoryItemsBySubarea",
+		"subareaid", req.GetSubareaId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get all inventory items in a facility
+func (s *InvAuth) GetInventoryItemsByFacility(ctx context.Context, req *pb.GetInventoryItemsByFacilityRequest) (*pb.GetInventoryItemsByFacilityResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.GetInventoryItemsByFacilityResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.GetInventoryItemsByFacility(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetInventoryItemsByFacility",
+		"facilityid", req.GetFacilityId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get inventory items by a list of ids
+func (s *InvAuth) GetInventoryItemsByIds(ctx context.Context, req *pb.GetInventoryItemsByIdsRequest) (*pb.GetInventoryItemsByIdsResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.GetInventoryItemsByIdsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.GetInventoryItemsByIds(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetInventoryItemsByIds",
+		"ids", len(req.GetInventoryItemIds()),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get the facilities, subareas, products, items and types changed after a cursor
+func (s *InvAuth) GetChangesSince(ctx context.Context, req *pb.GetChangesSinceRequest) (*pb.GetChangesSinceResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.GetChangesSinceResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.GetChangesSince(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetChangesSince",
+		"pagesize", req.GetPageSize(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// stream all inventory items by facility
+func (s *InvAuth) StreamInventoryItemsByFacility(req *pb.StreamInventoryItemsByFacilityRequest, stream pb.MServiceInventory_StreamInventoryItemsByFacilityServer) error {
+	start := time.Now().UnixNano()
+	resp := &pb.StreamInventoryItemsByFacilityResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(stream.Context())
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			err = s.invService.StreamInventoryItemsByFacility(req, stream)
+			resp = nil
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	if resp != nil {
+		err = stream.Send(resp)
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "StreamInventoryItemsByFacility",
+		"facilityid", req.GetFacilityId(),
+		"error", err, "duration", duration)
+
+	return err
+}
+
+// watch create, update and delete events for the inventory items of a facility, subarea subtree or product
+func (s *InvAuth) WatchInventory(req *pb.WatchInventoryRequest, stream pb.MServiceInventory_WatchInventoryServer) error {
+	start := time.Now().UnixNano()
+	resp := &pb.WatchInventoryResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(stream.Context())
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			err = s.invService.WatchInventory(req, stream)
+			resp = nil
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	if resp != nil {
+		err = stream.Send(resp)
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "WatchInventory",
+		"facilityid", req.GetFacilityId(), "subareaid", req.GetSubareaId(), "productid", req.GetProductId(),
+		"error", err, "duration", duration)
+
+	return err
+}
+
+// get current server version and uptime - health check
+func (s *InvAuth) GetServerVersion(ctx context.Context, req *pb.GetServerVersionRequest) (*pb.GetServerVersionResponse, error) {
+	return s.invService.GetServerVersion(ctx, req)
+}
+
+// create an entity schema
+func (s *InvAuth) CreateEntitySchema(ctx context.Context, req *pb.CreateEntitySchemaRequest) (*pb.CreateEntitySchemaResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.CreateEntitySchemaResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasAdminAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			req.RequestId = GetRequestIdFromContext(ctx, req.GetRequestId())
+			resp, err = s.invService.CreateEntitySchema(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateEntitySchema",
+		"entity", req.GetEntityName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
 
 	return resp, err
 }
@@ -1336,3 +2053,144 @@ func (s *InvAuth) GetEntitySchemas(ctx context.Context, req *pb.GetEntitySchemas
 
 	return resp, err
 }
+
+// index a json_data extension field of an entity for filtering
+func (s *InvAuth) CreateJsonIndex(ctx context.Context, req *pb.CreateJsonIndexRequest) (*pb.CreateJsonIndexResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.CreateJsonIndexResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasAdminAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			req.RequestId = GetRequestIdFromContext(ctx, req.GetRequestId())
+			resp, err = s.invService.CreateJsonIndex(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateJsonIndex",
+		"entity", req.GetEntityName(),
+		"path", req.GetJsonPath(),
+		"type", req.GetValueType(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete a json_data extension field index
+func (s *InvAuth) DeleteJsonIndex(ctx context.Context, req *pb.DeleteJsonIndexRequest) (*pb.DeleteJsonIndexResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.DeleteJsonIndexResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasAdminAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.DeleteJsonIndex(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteJsonIndex",
+		"entity", req.GetEntityName(),
+		"path", req.GetJsonPath(),
+		"type", req.GetValueType(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get all json_data extension field indexes for account
+func (s *InvAuth) GetJsonIndexes(ctx context.Context, req *pb.GetJsonIndexesRequest) (*pb.GetJsonIndexesResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.GetJsonIndexesResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if HasReadAccess(claims) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.GetJsonIndexes(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetJsonIndexes",
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// run an ordered list of create, update and delete operations in a single transaction
+func (s *InvAuth) Batch(ctx context.Context, req *pb.BatchRequest) (*pb.BatchResponse, error) {
+	start := time.Now().UnixNano()
+	resp := &pb.BatchResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	claims, err := s.GetJwtFromContext(ctx)
+	if err == nil {
+		if hasBatchAccess(claims, req) {
+			req.MserviceId = GetInt64FromClaims(claims, "aid")
+			resp, err = s.invService.Batch(ctx, req)
+		}
+	} else {
+		if err.Error() == tokenExpiredMatch {
+			resp.ErrorCode = 498
+			resp.ErrorMessage = tokenExpiredMessage
+		}
+
+		err = nil
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "Batch",
+		"operations", len(req.GetOperations()),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// Helper to see if JWT provides the access every operation of a batch needs, which is admin access for facilities
+// and for deleting a product with reassign_to.
+func hasBatchAccess(claims *map[string]interface{}, req *pb.BatchRequest) bool {
+	for _, op := range req.GetOperations() {
+		switch op.GetOperation().(type) {
+		case *pb.BatchOperation_CreateFacility, *pb.BatchOperation_UpdateFacility, *pb.BatchOperation_DeleteFacility:
+			return HasAdminAccess(claims)
+		}
+
+		if op.GetDeleteProduct().GetReassignTo() != 0 {
+			return HasAdminAccess(claims)
+		}
+	}
+
+	return HasRWAccess(claims)
+}
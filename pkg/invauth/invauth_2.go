@@ -0,0 +1,193 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invauth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// domain of the ErrorInfo detail of a status
+const statusDomain = "inventory.gaterace.com"
+
+// A response carrying an error code, message and reason.
+type errorResponse interface {
+	GetErrorCode() int32
+	GetErrorMessage() string
+	GetErrorReason() string
+}
+
+// gRPC status code and ErrorInfo reason for a response error code
+type statusMapping struct {
+	code   codes.Code
+	reason string
+}
+
+// Status for each response error code. A 401 is PermissionDenied if the request has a valid token, and
+// Unauthenticated if not.
+var statusMappings = map[int32]statusMapping{
+	401: {codes.PermissionDenied, "NOT_AUTHORIZED"},
+	404: {codes.NotFound, "NOT_FOUND"},
+	409: {codes.Aborted, "VERSION_CONFLICT"},
+	498: {codes.Unauthenticated, "TOKEN_EXPIRED"},
+	500: {codes.Internal, "DATABASE_ERROR"},
+	501: {codes.Internal, "DATABASE_ERROR"},
+	510: {codes.InvalidArgument, "INVALID_REQUEST"},
+}
+
+// Status code for each error reason. The service gives a reason when it refuses a request because of the stored
+// data rather than because the request is invalid in itself, and the reason is also the ErrorInfo reason.
+var reasonCodes = map[string]codes.Code{
+	"NAME_IN_USE":        codes.AlreadyExists,
+	"RULE_EXISTS":        codes.AlreadyExists,
+	"REQUEST_ID_REUSED":  codes.FailedPrecondition,
+	"PARENT_DELETED":     codes.FailedPrecondition,
+	"NOT_DELETED":        codes.FailedPrecondition,
+	"NOT_A_CHILD":        codes.FailedPrecondition,
+	"CHILDREN_CHANGED":   codes.FailedPrecondition,
+	"TYPE_RULE":          codes.FailedPrecondition,
+	"HAS_DEPENDENTS":     codes.FailedPrecondition,
+	"NONCONFORMING_DATA": codes.FailedPrecondition,
+}
+
+// Unary interceptor that turns a response with a non-zero error code into a gRPC status error with error details,
+// for clients and retry policies that only look at the status. Without it, every method succeeds at the gRPC level
+// and the error is only in the response.
+func (s *InvAuth) StatusUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	if eresp, ok := resp.(errorResponse); ok && (eresp.GetErrorCode() != 0) {
+		return nil, s.errorStatus(ctx, info.FullMethod, eresp)
+	}
+
+	return resp, nil
+}
+
+// Stream interceptor that ends a stream with a gRPC status error in place of a message with a non-zero error code.
+func (s *InvAuth) StatusStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	stream := &statusStream{ServerStream: ss, auth: s, method: info.FullMethod}
+	err := handler(srv, stream)
+	if stream.err != nil {
+		return stream.err
+	}
+
+	return err
+}
+
+// Server stream that turns a message with a non-zero error code into a status error.
+type statusStream struct {
+	grpc.ServerStream
+	auth   *InvAuth
+	method string
+	err    error
+}
+
+// Send a message, or return a status error instead of sending a message with an error code.
+func (ss *statusStream) SendMsg(m interface{}) error {
+	if eresp, ok := m.(errorResponse); ok && (eresp.GetErrorCode() != 0) {
+		ss.err = ss.auth.errorStatus(ss.Context(), ss.method, eresp)
+		return ss.err
+	}
+
+	return ss.ServerStream.SendMsg(m)
+}
+
+// Helper to build the status error for a response error code, message and reason.
+func (s *InvAuth) errorStatus(ctx context.Context, method string, eresp errorResponse) error {
+	errorCode := eresp.GetErrorCode()
+	msg := eresp.GetErrorMessage()
+
+	mapping, ok := statusMappings[errorCode]
+	if !ok {
+		mapping = statusMapping{codes.Unknown, "UNKNOWN"}
+	}
+
+	if errorCode == 401 {
+		if _, err := s.GetJwtFromContext(ctx); err != nil {
+			mapping = statusMapping{codes.Unauthenticated, "TOKEN_INVALID"}
+		}
+	}
+
+	if code, ok := reasonCodes[eresp.GetErrorReason()]; ok {
+		mapping = statusMapping{code, eresp.GetErrorReason()}
+	}
+
+	st := status.New(mapping.code, msg)
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason: mapping.reason,
+			Domain: statusDomain,
+			Metadata: map[string]string{
+				"error_code": strconv.Itoa(int(errorCode)),
+				"method":     method,
+			},
+		},
+	}
+
+	switch mapping.code {
+	case codes.InvalidArgument:
+		if field := violatedField(msg); field != "" {
+			details = append(details, &errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: msg}},
+			})
+		}
+	case codes.FailedPrecondition:
+		details = append(details, &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{Type: mapping.reason, Description: msg}},
+		})
+
+		// the response lists the records that do not match the schema
+		if listing, ok := eresp.(protoadapt.MessageV1); ok && (mapping.reason == "NONCONFORMING_DATA") {
+			details = append(details, listing)
+		}
+	case codes.Aborted:
+		// the response carries the current version and record for the client to retry against
+		if current, ok := eresp.(protoadapt.MessageV1); ok {
+			details = append(details, current)
+		}
+	}
+
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
+
+// Helper to get the request field named by a validation message, such as "subarea_id missing", "product_id 12 is
+// deleted" or "nodes[1].json_data does not match the subarea schema", or "" if the message is not about a single field.
+func violatedField(msg string) string {
+	field, rest, _ := strings.Cut(msg, " ")
+	name := field[strings.LastIndex(field, ".")+1:]
+	if (rest == "missing") || ((rest != "") && (strings.HasSuffix(name, "_id") || strings.HasPrefix(name, "json_"))) {
+		return field
+	}
+
+	return ""
+}
@@ -0,0 +1,75 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invauth
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		resp   errorResponse
+		code   codes.Code
+		reason string
+	}{
+		{"not found", &pb.GetProductResponse{ErrorCode: 404, ErrorMessage: "not found"},
+			codes.NotFound, "NOT_FOUND"},
+		{"invalid", &pb.CreateProductResponse{ErrorCode: 510, ErrorMessage: "product_name missing"},
+			codes.InvalidArgument, "INVALID_REQUEST"},
+		{"name in use", &pb.UndeleteProductResponse{ErrorCode: 510, ErrorMessage: "product_name 'a' is in use by another product",
+			ErrorReason: "NAME_IN_USE"}, codes.AlreadyExists, "NAME_IN_USE"},
+		{"duplicate name", &pb.CreateSubareaResponse{ErrorCode: 501, ErrorMessage: "subarea_name 'a' already exists",
+			ErrorReason: "NAME_IN_USE"}, codes.AlreadyExists, "NAME_IN_USE"},
+		{"type rule", &pb.CreateInventoryItemResponse{ErrorCode: 510, ErrorMessage: "item type 3 is not allowed in subarea type 2",
+			ErrorReason: "TYPE_RULE"}, codes.FailedPrecondition, "TYPE_RULE"},
+		{"dependents", &pb.DeleteProductResponse{ErrorCode: 510, ErrorMessage: "product 4 is referenced by 2 live inventory items",
+			ErrorReason: "HAS_DEPENDENTS"}, codes.FailedPrecondition, "HAS_DEPENDENTS"},
+		// only the reason decides the status, not the wording of the message
+		{"conflict wording without reason", &pb.CreateInventoryItemResponse{ErrorCode: 510,
+			ErrorMessage: "json_data value is not allowed in this field"}, codes.InvalidArgument, "INVALID_REQUEST"},
+		{"unknown reason", &pb.CreateProductResponse{ErrorCode: 510, ErrorMessage: "sku missing", ErrorReason: "OTHER"},
+			codes.InvalidArgument, "INVALID_REQUEST"},
+		{"unknown code", &pb.CreateProductResponse{ErrorCode: 999, ErrorMessage: "odd"}, codes.Unknown, "UNKNOWN"},
+	}
+
+	auth := &InvAuth{}
+	for _, tt := range tests {
+		st := status.Convert(auth.errorStatus(context.Background(), "/test/Method", tt.resp))
+		if st.Code() != tt.code {
+			t.Errorf("%s: code = %v, want %v", tt.name, st.Code(), tt.code)
+		}
+
+		if st.Message() != tt.resp.GetErrorMessage() {
+			t.Errorf("%s: message = %q", tt.name, st.Message())
+		}
+
+		var info *errdetails.ErrorInfo
+		for _, detail := range st.Details() {
+			if ei, ok := detail.(*errdetails.ErrorInfo); ok {
+				info = ei
+			}
+		}
+
+		if (info == nil) || (info.GetReason() != tt.reason) {
+			t.Errorf("%s: error info = %v, want reason %s", tt.name, info, tt.reason)
+		}
+	}
+}
@@ -30,110 +30,44 @@ import (
 
 	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 var NotImplemented = errors.New("not implemented")
 
-var supportedEntities = map[string]bool{
-	"facility":                            true,
-	"facilitywrapper":                     true,
-	"subareatype":                         true,
-	"itemtype":                            true,
-	"subarea":                             true,
-	"subareawrapper":                      true,
-	"product":                             true,
-	"inventoryitem":                       true,
-	"entityschema":                        true,
-	"createfacilityrequest":               true,
-	"createfacilityresponse":              true,
-	"updatefacilityrequest":               true,
-	"updatefacilityresponse":              true,
-	"deletefacilityrequest":               true,
-	"deletefacilityresponse":              true,
-	"getfacilityrequest":                  true,
-	"getfacilityresponse":                 true,
-	"getfacilitiesrequest":                true,
-	"getfacilitiesresponse":               true,
-	"getfacilitywrapperrequest":           true,
-	"getfacilitywrapperresponse":          true,
-	"createsubareatyperequest":            true,
-	"createsubareatyperesponse":           true,
-	"updatesubareatyperequest":            true,
-	"updatesubareatyperesponse":           true,
-	"deletesubareatyperequest":            true,
-	"deletesubareatyperesponse":           true,
-	"getsubareatyperequest":               true,
-	"getsubareatyperesponse":              true,
-	"getsubareatypesrequest":              true,
-	"getsubareatypesresponse":             true,
-	"createitemtyperequest":               true,
-	"createitemtyperesponse":              true,
-	"updateitemtyperequest":               true,
-	"updateitemtyperesponse":              true,
-	"deleteitemtyperequest":               true,
-	"deleteitemtyperesponse":              true,
-	"getitemtyperequest":                  true,
-	"getitemtyperesponse":                 true,
-	"getitemtypesrequest":                 true,
-	"getitemtypesresponse":                true,
-	"createsubarearequest":                true,
-	"createsubarearesponse":               true,
-	"updatesubarearequest":                true,
-	"updatesubarearesponse":               true,
-	"deletesubarearequest":                true,
-	"deletesubarearesponse":               true,
-	"getsubarearequest":                   true,
-	"getsubarearesponse":                  true,
-	"getsubareasrequest":                  true,
-	"getsubareasresponse":                 true,
-	"createproductrequest":                true,
-	"createproductresponse":               true,
-	"updateproductrequest":                true,
-	"updateproductresponse":               true,
-	"deleteproductrequest":                true,
-	"deleteproductresponse":               true,
-	"getproductrequest":                   true,
-	"getproductresponse":                  true,
-	"getproductsrequest":                  true,
-	"getproductsresponse":                 true,
-	"createinventoryitemrequest":          true,
-	"createinventoryitemresponse":         true,
-	"updateinventoryitemrequest":          true,
-	"updateinventoryitemresponse":         true,
-	"deleteinventoryitemrequest":          true,
-	"deleteinventoryitemresponse":         true,
-	"getinventoryitemrequest":             true,
-	"getinventoryitemresponse":            true,
-	"getinventoryitemsbyproductrequest":   true,
-	"getinventoryitemsbyproductresponse":  true,
-	"getinventoryitemsbysubarearequest":   true,
-	"getinventoryitemsbysubarearesponse":  true,
-	"getinventoryitemsbyfacilityrequest":  true,
-	"getinventoryitemsbyfacilityresponse": true,
-	"getserverversionrequest":             true,
-	"getserverversionresponse":            true,
-	"createentityschemarequest":           true,
-	"createentityschemaresponse":          true,
-	"updateentityschemarequest":           true,
-	"updateentityschemaresponse":          true,
-	"deleteentityschemarequest":           true,
-	"deleteentityschemaresponse":          true,
-	"getentityschemarequest":              true,
-	"getentityschemaresponse":             true,
-	"getentityschemasrequest":             true,
-	"getentityschemasresponse":            true}
+// table and key column of an entity with a json_data extension attribute
+type jsonDataTable struct {
+	table  string
+	column string
+}
+
+// entities with a json_data extension attribute, the only ones an entity schema can describe
+var supportedEntities = map[string]jsonDataTable{
+	"facility":      {"tb_Facility", "inbFacilityId"},
+	"subarea":       {"tb_Subarea", "inbSubareaId"},
+	"product":       {"tb_Product", "inbProductId"},
+	"inventoryitem": {"tb_InventoryItem", "inbInventoryItemId"},
+}
+
+// error message for an entity schema of any other entity
+const unsupportedEntityMessage = "schema entity_name not supported, must be facility, subarea, product or inventoryitem"
 
 type invService struct {
 	pb.UnimplementedMServiceInventoryServer
-	logger    log.Logger
-	db        *sql.DB
-	startSecs int64
+	logger            log.Logger
+	db                dbQueryer
+	pool              *sql.DB
+	startSecs         int64
+	idempotencyWindow time.Duration
+	purgeRetention    time.Duration
+	jsonIndexDdl      bool
 }
 
 // Get a new invService instance.
 func NewInvService() *invService {
 	svc := invService{}
 	svc.startSecs = time.Now().Unix()
+	svc.idempotencyWindow = DefaultIdempotencyWindow
 	return &svc
 }
 
@@ -145,6 +79,7 @@ func (s *invService) SetLogger(logger log.Logger) {
 // Set the database connection for the invService instance.
 func (s *invService) SetDatabaseConnection(sqlDB *sql.DB) {
 	s.db = sqlDB
+	s.pool = sqlDB
 }
 
 // Get a GRPC api server using the invService instance.
@@ -158,6 +93,33 @@ func (s *invService) NewApiServer(gServer *grpc.Server) error {
 
 // create new facility
 func (s *invService) CreateFacility(ctx context.Context, req *pb.CreateFacilityRequest) (*pb.CreateFacilityResponse, error) {
+	if req.GetRequestId() != "" {
+		resp := &pb.CreateFacilityResponse{}
+		s.createOnce(req, resp, func(txs *invService, once proto.Message) createResponse {
+			created, _ := txs.CreateFacility(ctx, once.(*pb.CreateFacilityRequest))
+			return created
+		})
+
+		return resp, nil
+	}
+
+	resp := &pb.CreateFacilityResponse{}
+
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.createFacility(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.CreateFacilityResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to create a new facility after checking its json_data.
+func (s *invService) createFacility(ctx context.Context, req *pb.CreateFacilityRequest) (*pb.CreateFacilityResponse, error) {
 	resp := &pb.CreateFacilityResponse{}
 
 	name := strings.TrimSpace(req.GetFacilityName())
@@ -167,6 +129,20 @@ func (s *invService) CreateFacility(ctx context.Context, req *pb.CreateFacilityR
 		return resp, nil
 	}
 
+	msg, err := s.checkJsonData(req.GetMserviceId(), "facility", req.GetJsonData())
+	if err != nil {
+		level.Error(s.logger).Log("what", "checkJsonData", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
 	sqlstring := `INSERT INTO tb_Facility (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, 
  		inbMserviceId, chvFacilityName, chvJsonData) VALUES(NOW(), NOW(), NOW(), 0, 1, ?, ?, ?)`
 
@@ -205,6 +181,40 @@ func (s *invService) CreateFacility(ctx context.Context, req *pb.CreateFacilityR
 // update an existing facility
 func (s *invService) UpdateFacility(ctx context.Context, req *pb.UpdateFacilityRequest) (*pb.UpdateFacilityResponse, error) {
 	resp := &pb.UpdateFacilityResponse{}
+
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.updateFacility(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.UpdateFacilityResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to update an existing facility after checking its json_data.
+func (s *invService) updateFacility(ctx context.Context, req *pb.UpdateFacilityRequest) (*pb.UpdateFacilityResponse, error) {
+	resp := &pb.UpdateFacilityResponse{}
+
+	if hasUpdateMask(req.GetUpdateMask()) {
+		current, _ := s.GetFacility(ctx, &pb.GetFacilityRequest{MserviceId: req.GetMserviceId(), FacilityId: req.GetFacilityId()})
+		if current.GetErrorCode() != 0 {
+			resp.ErrorCode = current.GetErrorCode()
+			resp.ErrorMessage = current.GetErrorMessage()
+			return resp, nil
+		}
+
+		msg := applyUpdateMask(req.GetUpdateMask(), req, current.GetFacility(), "facility_id")
+		if msg != "" {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = msg
+			return resp, nil
+		}
+	}
+
 	name := strings.TrimSpace(req.GetFacilityName())
 	if name == "" {
 		resp.ErrorCode = 510
@@ -212,6 +222,20 @@ func (s *invService) UpdateFacility(ctx context.Context, req *pb.UpdateFacilityR
 		return resp, nil
 	}
 
+	msg, err := s.checkJsonData(req.GetMserviceId(), "facility", req.GetJsonData())
+	if err != nil {
+		level.Error(s.logger).Log("what", "checkJsonData", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
 	sqlstring := `UPDATE tb_Facility SET dtmModified = NOW(), intVersion = intVersion + 1, chvFacilityName = ?, 
 	chvJsonData = ? 
 	WHERE inbFacilityId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = 0`
@@ -232,8 +256,13 @@ func (s *invService) UpdateFacility(ctx context.Context, req *pb.UpdateFacilityR
 		if rowsAffected == 1 {
 			resp.Version = req.GetVersion() + 1
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetFacility(ctx, &pb.GetFacilityRequest{MserviceId: req.GetMserviceId(), FacilityId: req.GetFacilityId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetFacility().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetFacility().GetVersion()
+				resp.Facility = current.GetFacility()
+			}
 		}
 	} else {
 		resp.ErrorCode = 501
@@ -268,8 +297,13 @@ func (s *invService) DeleteFacility(ctx context.Context, req *pb.DeleteFacilityR
 		if rowsAffected == 1 {
 			resp.Version = req.GetVersion() + 1
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetFacility(ctx, &pb.GetFacilityRequest{MserviceId: req.GetMserviceId(), FacilityId: req.GetFacilityId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetFacility().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetFacility().GetVersion()
+				resp.Facility = current.GetFacility()
+			}
 		}
 	} else {
 		resp.ErrorCode = 501
@@ -285,7 +319,7 @@ func (s *invService) DeleteFacility(ctx context.Context, req *pb.DeleteFacilityR
 func (s *invService) GetFacility(ctx context.Context, req *pb.GetFacilityRequest) (*pb.GetFacilityResponse, error) {
 	resp := &pb.GetFacilityResponse{}
 
-	gResp, facility := s.GetFacilityHelper(req.GetMserviceId(), req.GetFacilityId())
+	gResp, facility := s.GetFacilityHelper(req.GetMserviceId(), req.GetFacilityId(), req.GetIncludeDeleted())
 	resp.ErrorCode = gResp.ErrorCode
 	resp.ErrorMessage = gResp.ErrorMessage
 	if gResp.ErrorCode == 0 {
@@ -299,8 +333,20 @@ func (s *invService) GetFacility(ctx context.Context, req *pb.GetFacilityRequest
 func (s *invService) GetFacilities(ctx context.Context, req *pb.GetFacilitiesRequest) (*pb.GetFacilitiesResponse, error) {
 	resp := &pb.GetFacilitiesResponse{}
 
-	sqlstring := `SELECT inbFacilityId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvFacilityName, chvJsonData
-	FROM tb_Facility WHERE inbMserviceId = ? AND bitIsDeleted = 0`
+	page, msg := parsePage(req.GetPageSize(), req.GetPageToken(), "", 0)
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	fromWhere := `FROM tb_Facility WHERE inbMserviceId = ?` + liveClause("", req.GetIncludeDeleted())
+	args := []interface{}{req.GetMserviceId()}
+	pageClause, pageArgs := page.clause("inbFacilityId", nil, args)
+
+	sqlstring := `SELECT inbFacilityId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+	chvFacilityName, chvJsonData
+	` + fromWhere + pageClause
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -312,7 +358,7 @@ func (s *invService) GetFacilities(ctx context.Context, req *pb.GetFacilitiesReq
 
 	defer stmt.Close()
 
-	rows, err := stmt.Query(req.GetMserviceId())
+	rows, err := stmt.Query(pageArgs...)
 
 	if err != nil {
 		level.Error(s.logger).Log("what", "Query", "error", err)
@@ -325,9 +371,10 @@ func (s *invService) GetFacilities(ctx context.Context, req *pb.GetFacilitiesReq
 	for rows.Next() {
 		var created string
 		var modified string
+		var deleted string
 		var facility pb.Facility
 
-		err := rows.Scan(&facility.FacilityId, &created, &modified,
+		err := rows.Scan(&facility.FacilityId, &created, &modified, &deleted, &facility.IsDeleted,
 			&facility.Version, &facility.MserviceId, &facility.FacilityName, &facility.JsonData)
 
 		if err != nil {
@@ -339,9 +386,27 @@ func (s *invService) GetFacilities(ctx context.Context, req *pb.GetFacilitiesReq
 
 		facility.Created = dml.DateTimeFromString(created)
 		facility.Modified = dml.DateTimeFromString(modified)
+		if facility.IsDeleted {
+			facility.Deleted = dml.DateTimeFromString(deleted)
+		}
 		resp.Facilities = append(resp.Facilities, &facility)
 	}
 
+	if page.more(len(resp.Facilities)) {
+		resp.Facilities = resp.Facilities[:page.size]
+		resp.NextPageToken = page.nextToken(resp.Facilities[page.size-1].GetFacilityId(), nil)
+	}
+
+	if req.GetIncludeTotalCount() {
+		resp.TotalCount, err = s.countRows(fromWhere, args)
+		if err != nil {
+			level.Error(s.logger).Log("what", "countRows", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+	}
+
 	return resp, nil
 
 }
@@ -350,7 +415,7 @@ func (s *invService) GetFacilities(ctx context.Context, req *pb.GetFacilitiesReq
 func (s *invService) GetFacilityWrapper(ctx context.Context, req *pb.GetFacilityWrapperRequest) (*pb.GetFacilityWrapperResponse, error) {
 	resp := &pb.GetFacilityWrapperResponse{}
 
-	gResp, facility := s.GetFacilityHelper(req.GetMserviceId(), req.GetFacilityId())
+	gResp, facility := s.GetFacilityHelper(req.GetMserviceId(), req.GetFacilityId(), false)
 	resp.ErrorCode = gResp.ErrorCode
 	resp.ErrorMessage = gResp.ErrorMessage
 
@@ -363,7 +428,7 @@ func (s *invService) GetFacilityWrapper(ctx context.Context, req *pb.GetFacility
 	wraps := make([]*pb.SubareaWrapper, 0)
 	subMap := make(map[int64]*pb.SubareaWrapper)
 
-	gResp, subareas := s.GetSubareasHelper(req.GetMserviceId(), req.GetFacilityId())
+	gResp, subareas := s.GetSubareasHelper(s.db, req.GetMserviceId(), req.GetFacilityId(), nil, false)
 	if gResp.ErrorCode != 0 {
 		resp.ErrorCode = gResp.ErrorCode
 		resp.ErrorMessage = gResp.ErrorMessage
@@ -394,6 +459,16 @@ func (s *invService) GetFacilityWrapper(ctx context.Context, req *pb.GetFacility
 
 // create new subarea type
 func (s *invService) CreateSubareaType(ctx context.Context, req *pb.CreateSubareaTypeRequest) (*pb.CreateSubareaTypeResponse, error) {
+	if req.GetRequestId() != "" {
+		resp := &pb.CreateSubareaTypeResponse{}
+		s.createOnce(req, resp, func(txs *invService, once proto.Message) createResponse {
+			created, _ := txs.CreateSubareaType(ctx, once.(*pb.CreateSubareaTypeRequest))
+			return created
+		})
+
+		return resp, nil
+	}
+
 	resp := &pb.CreateSubareaTypeResponse{}
 
 	name := strings.TrimSpace(req.GetSubareaTypeName())
@@ -433,6 +508,23 @@ func (s *invService) CreateSubareaType(ctx context.Context, req *pb.CreateSubare
 // update an existing subarea type
 func (s *invService) UpdateSubareaType(ctx context.Context, req *pb.UpdateSubareaTypeRequest) (*pb.UpdateSubareaTypeResponse, error) {
 	resp := &pb.UpdateSubareaTypeResponse{}
+
+	if hasUpdateMask(req.GetUpdateMask()) {
+		current, _ := s.GetSubareaType(ctx, &pb.GetSubareaTypeRequest{MserviceId: req.GetMserviceId(), SubareaTypeId: req.GetSubareaTypeId()})
+		if current.GetErrorCode() != 0 {
+			resp.ErrorCode = current.GetErrorCode()
+			resp.ErrorMessage = current.GetErrorMessage()
+			return resp, nil
+		}
+
+		msg := applyUpdateMask(req.GetUpdateMask(), req, current.GetSubareaType(), "subarea_type_id")
+		if msg != "" {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = msg
+			return resp, nil
+		}
+	}
+
 	name := strings.TrimSpace(req.GetSubareaTypeName())
 	if name == "" {
 		resp.ErrorCode = 510
@@ -459,8 +551,13 @@ func (s *invService) UpdateSubareaType(ctx context.Context, req *pb.UpdateSubare
 		if rowsAffected == 1 {
 			resp.Version = req.GetVersion() + 1
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetSubareaType(ctx, &pb.GetSubareaTypeRequest{MserviceId: req.GetMserviceId(), SubareaTypeId: req.GetSubareaTypeId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetSubareaType().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetSubareaType().GetVersion()
+				resp.SubareaType = current.GetSubareaType()
+			}
 		}
 	} else {
 		resp.ErrorCode = 501
@@ -476,6 +573,23 @@ func (s *invService) UpdateSubareaType(ctx context.Context, req *pb.UpdateSubare
 func (s *invService) DeleteSubareaType(ctx context.Context, req *pb.DeleteSubareaTypeRequest) (*pb.DeleteSubareaTypeResponse, error) {
 	resp := &pb.DeleteSubareaTypeResponse{}
 
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.deleteSubareaType(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.DeleteSubareaTypeResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to delete an existing subarea type, once no live records refer to it or they have been reassigned.
+func (s *invService) deleteSubareaType(ctx context.Context, req *pb.DeleteSubareaTypeRequest) (*pb.DeleteSubareaTypeResponse, error) {
+	resp := &pb.DeleteSubareaTypeResponse{}
+
 	sqlstring := `UPDATE tb_SubareaType SET dtmDeleted = NOW(), bitIsDeleted = 1, intVersion = intVersion + 1
 	WHERE inbMserviceId = ? AND intSubareaTypeId = ? AND intVersion = ? AND bitIsDeleted = 0`
 
@@ -493,10 +607,27 @@ func (s *invService) DeleteSubareaType(ctx context.Context, req *pb.DeleteSubare
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
 		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
+			reassigned, msg, reason, err := s.releaseSubareaType(req.GetMserviceId(), req.GetSubareaTypeId(), req.GetReassignTo())
+			if err != nil {
+				level.Error(s.logger).Log("what", "releaseSubareaType", "error", err)
+				resp.ErrorCode = 500
+				resp.ErrorMessage = err.Error()
+			} else if msg != "" {
+				resp.ErrorCode = 510
+				resp.ErrorMessage = msg
+				resp.ErrorReason = reason
+			} else {
+				resp.Version = req.GetVersion() + 1
+				resp.Reassigned = reassigned
+			}
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetSubareaType(ctx, &pb.GetSubareaTypeRequest{MserviceId: req.GetMserviceId(), SubareaTypeId: req.GetSubareaTypeId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetSubareaType().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetSubareaType().GetVersion()
+				resp.SubareaType = current.GetSubareaType()
+			}
 		}
 	} else {
 		resp.ErrorCode = 501
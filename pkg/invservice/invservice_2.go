@@ -16,6 +16,7 @@ package invservice
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"strings"
 	"time"
 
@@ -26,10 +27,21 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 
 	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+	"google.golang.org/protobuf/proto"
 )
 
 // create new item type
 func (s *invService) CreateItemType(ctx context.Context, req *pb.CreateItemTypeRequest) (*pb.CreateItemTypeResponse, error) {
+	if req.GetRequestId() != "" {
+		resp := &pb.CreateItemTypeResponse{}
+		s.createOnce(req, resp, func(txs *invService, once proto.Message) createResponse {
+			created, _ := txs.CreateItemType(ctx, once.(*pb.CreateItemTypeRequest))
+			return created
+		})
+
+		return resp, nil
+	}
+
 	resp := &pb.CreateItemTypeResponse{}
 
 	name := strings.TrimSpace(req.GetItemTypeName())
@@ -70,6 +82,22 @@ func (s *invService) CreateItemType(ctx context.Context, req *pb.CreateItemTypeR
 func (s *invService) UpdateItemType(ctx context.Context, req *pb.UpdateItemTypeRequest) (*pb.UpdateItemTypeResponse, error) {
 	resp := &pb.UpdateItemTypeResponse{}
 
+	if hasUpdateMask(req.GetUpdateMask()) {
+		current, _ := s.GetItemType(ctx, &pb.GetItemTypeRequest{MserviceId: req.GetMserviceId(), ItemTypeId: req.GetItemTypeId()})
+		if current.GetErrorCode() != 0 {
+			resp.ErrorCode = current.GetErrorCode()
+			resp.ErrorMessage = current.GetErrorMessage()
+			return resp, nil
+		}
+
+		msg := applyUpdateMask(req.GetUpdateMask(), req, current.GetItemType(), "item_type_id")
+		if msg != "" {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = msg
+			return resp, nil
+		}
+	}
+
 	name := strings.TrimSpace(req.GetItemTypeName())
 	if name == "" {
 		resp.ErrorCode = 510
@@ -96,8 +124,13 @@ func (s *invService) UpdateItemType(ctx context.Context, req *pb.UpdateItemTypeR
 		if rowsAffected == 1 {
 			resp.Version = req.GetVersion() + 1
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetItemType(ctx, &pb.GetItemTypeRequest{MserviceId: req.GetMserviceId(), ItemTypeId: req.GetItemTypeId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetItemType().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetItemType().GetVersion()
+				resp.ItemType = current.GetItemType()
+			}
 		}
 	} else {
 		resp.ErrorCode = 501
@@ -113,6 +146,23 @@ func (s *invService) UpdateItemType(ctx context.Context, req *pb.UpdateItemTypeR
 func (s *invService) DeleteItemType(ctx context.Context, req *pb.DeleteItemTypeRequest) (*pb.DeleteItemTypeResponse, error) {
 	resp := &pb.DeleteItemTypeResponse{}
 
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.deleteItemType(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.DeleteItemTypeResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to delete an existing item type, once no live records refer to it or they have been reassigned.
+func (s *invService) deleteItemType(ctx context.Context, req *pb.DeleteItemTypeRequest) (*pb.DeleteItemTypeResponse, error) {
+	resp := &pb.DeleteItemTypeResponse{}
+
 	sqlstring := `UPDATE tb_ItemType SET dtmDeleted = NOW(), bitIsDeleted = 1, intVersion = intVersion + 1
 	WHERE inbMserviceId = ? AND intItemTypeId = ? AND intVersion = ? AND bitIsDeleted = 0`
 
@@ -130,10 +180,27 @@ func (s *invService) DeleteItemType(ctx context.Context, req *pb.DeleteItemTypeR
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
 		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
+			reassigned, msg, reason, err := s.releaseItemType(req.GetMserviceId(), req.GetItemTypeId(), req.GetReassignTo())
+			if err != nil {
+				level.Error(s.logger).Log("what", "releaseItemType", "error", err)
+				resp.ErrorCode = 500
+				resp.ErrorMessage = err.Error()
+			} else if msg != "" {
+				resp.ErrorCode = 510
+				resp.ErrorMessage = msg
+				resp.ErrorReason = reason
+			} else {
+				resp.Version = req.GetVersion() + 1
+				resp.Reassigned = reassigned
+			}
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetItemType(ctx, &pb.GetItemTypeRequest{MserviceId: req.GetMserviceId(), ItemTypeId: req.GetItemTypeId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetItemType().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetItemType().GetVersion()
+				resp.ItemType = current.GetItemType()
+			}
 		}
 	} else {
 		resp.ErrorCode = 501
@@ -240,6 +307,33 @@ func (s *invService) GetItemTypes(ctx context.Context, req *pb.GetItemTypesReque
 
 // create a new subarea
 func (s *invService) CreateSubarea(ctx context.Context, req *pb.CreateSubareaRequest) (*pb.CreateSubareaResponse, error) {
+	if req.GetRequestId() != "" {
+		resp := &pb.CreateSubareaResponse{}
+		s.createOnce(req, resp, func(txs *invService, once proto.Message) createResponse {
+			created, _ := txs.CreateSubarea(ctx, once.(*pb.CreateSubareaRequest))
+			return created
+		})
+
+		return resp, nil
+	}
+
+	resp := &pb.CreateSubareaResponse{}
+
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.createSubarea(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.CreateSubareaResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to create a new subarea after checking its references and placement.
+func (s *invService) createSubarea(ctx context.Context, req *pb.CreateSubareaRequest) (*pb.CreateSubareaResponse, error) {
 	resp := &pb.CreateSubareaResponse{}
 
 	name := strings.TrimSpace(req.GetSubareaName())
@@ -249,6 +343,40 @@ func (s *invService) CreateSubarea(ctx context.Context, req *pb.CreateSubareaReq
 		return resp, nil
 	}
 
+	msg, err := s.checkSubareaReferences(s.db, req.GetMserviceId(), 0, req.GetFacilityId(), req.GetParentSubareaId(),
+		req.GetSubareaTypeId())
+	if (err == nil) && (msg == "") {
+		msg, err = s.checkSubareaPlacement(s.db, req.GetMserviceId(), req.GetParentSubareaId(), req.GetSubareaTypeId())
+		resp.ErrorReason = reasonFor(msg, reasonTypeRule)
+	}
+	if (err == nil) && (msg == "") {
+		msg, err = s.checkJsonData(req.GetMserviceId(), "subarea", req.GetJsonData())
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "checkSubareaReferences", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	position := req.GetPosition()
+	if req.GetAutoPosition() {
+		position, err = nextSubareaPosition(s.db, req.GetMserviceId(), req.GetFacilityId(), req.GetParentSubareaId(), 0)
+		if err != nil {
+			level.Error(s.logger).Log("what", "nextSubareaPosition", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+	}
+
 	sqlstring := `INSERT INTO tb_Subarea (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, 
 		inbFacilityId, inbParentSubareaId, intPosition, intSubareaTypeId, chvSubareaName, chvJsonData) 
 		VALUES(NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?)`
@@ -263,7 +391,7 @@ func (s *invService) CreateSubarea(ctx context.Context, req *pb.CreateSubareaReq
 
 	defer stmt.Close()
 
-	res, err := stmt.Exec(req.GetMserviceId(), req.GetFacilityId(), req.GetParentSubareaId(), req.GetPosition(),
+	res, err := stmt.Exec(req.GetMserviceId(), req.GetFacilityId(), req.GetParentSubareaId(), position,
 		req.GetSubareaTypeId(), name, req.GetJsonData())
 
 	if err == nil {
@@ -276,6 +404,12 @@ func (s *invService) CreateSubarea(ctx context.Context, req *pb.CreateSubareaReq
 
 		resp.SubareaId = subareaId
 		resp.Version = 1
+		resp.Position = position
+	} else if isDuplicateKey(err) {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = s.subareaConflictMessage(req.GetMserviceId(), req.GetFacilityId(), req.GetParentSubareaId(), name)
+		resp.ErrorReason = reasonNameInUse
+		err = nil
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
@@ -290,6 +424,39 @@ func (s *invService) CreateSubarea(ctx context.Context, req *pb.CreateSubareaReq
 func (s *invService) UpdateSubarea(ctx context.Context, req *pb.UpdateSubareaRequest) (*pb.UpdateSubareaResponse, error) {
 	resp := &pb.UpdateSubareaResponse{}
 
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.updateSubarea(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.UpdateSubareaResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to update an existing subarea after checking its references, placement and contents.
+func (s *invService) updateSubarea(ctx context.Context, req *pb.UpdateSubareaRequest) (*pb.UpdateSubareaResponse, error) {
+	resp := &pb.UpdateSubareaResponse{}
+
+	if hasUpdateMask(req.GetUpdateMask()) {
+		current, _ := s.GetSubarea(ctx, &pb.GetSubareaRequest{MserviceId: req.GetMserviceId(), SubareaId: req.GetSubareaId()})
+		if current.GetErrorCode() != 0 {
+			resp.ErrorCode = current.GetErrorCode()
+			resp.ErrorMessage = current.GetErrorMessage()
+			return resp, nil
+		}
+
+		msg := applyUpdateMask(req.GetUpdateMask(), req, current.GetSubarea(), "subarea_id")
+		if msg != "" {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = msg
+			return resp, nil
+		}
+	}
+
 	name := strings.TrimSpace(req.GetSubareaName())
 	if name == "" {
 		resp.ErrorCode = 510
@@ -297,6 +464,45 @@ func (s *invService) UpdateSubarea(ctx context.Context, req *pb.UpdateSubareaReq
 		return resp, nil
 	}
 
+	facilityId := s.getSubareaFacilityId(req.GetMserviceId(), req.GetSubareaId())
+	msg, err := s.checkSubareaReferences(s.db, req.GetMserviceId(), req.GetSubareaId(), facilityId,
+		req.GetParentSubareaId(), req.GetSubareaTypeId())
+	if (err == nil) && (msg == "") {
+		msg, err = s.checkSubareaPlacement(s.db, req.GetMserviceId(), req.GetParentSubareaId(), req.GetSubareaTypeId())
+		resp.ErrorReason = reasonFor(msg, reasonTypeRule)
+	}
+	if (err == nil) && (msg == "") {
+		msg, err = s.checkSubareaContents(s.db, req.GetMserviceId(), req.GetSubareaId(), req.GetSubareaTypeId())
+		resp.ErrorReason = reasonFor(msg, reasonTypeRule)
+	}
+	if (err == nil) && (msg == "") {
+		msg, err = s.checkJsonData(req.GetMserviceId(), "subarea", req.GetJsonData())
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "checkSubareaReferences", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	position := req.GetPosition()
+	if req.GetAutoPosition() {
+		position, err = nextSubareaPosition(s.db, req.GetMserviceId(), facilityId, req.GetParentSubareaId(), req.GetSubareaId())
+		if err != nil {
+			level.Error(s.logger).Log("what", "nextSubareaPosition", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+	}
+
 	sqlstring := `UPDATE tb_Subarea SET dtmModified = NOW(), intVersion = intVersion + 1, inbParentSubareaId = ?, 
 	intPosition = ?, intSubareaTypeId = ?, chvSubareaName = ?, chvJsonData = ? WHERE inbSubareaId = ? AND inbMserviceId = ? 
 	AND intVersion= ? AND bitIsDeleted= 0`
@@ -311,17 +517,28 @@ func (s *invService) UpdateSubarea(ctx context.Context, req *pb.UpdateSubareaReq
 
 	defer stmt.Close()
 
-	res, err := stmt.Exec(req.GetParentSubareaId(), req.GetPosition(), req.GetSubareaTypeId(), req.GetSubareaName(),
+	res, err := stmt.Exec(req.GetParentSubareaId(), position, req.GetSubareaTypeId(), name,
 		req.GetJsonData(), req.GetSubareaId(), req.GetMserviceId(), req.GetVersion())
 
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
 		if rowsAffected == 1 {
 			resp.Version = req.GetVersion() + 1
+			resp.Position = position
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetSubarea(ctx, &pb.GetSubareaRequest{MserviceId: req.GetMserviceId(), SubareaId: req.GetSubareaId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetSubarea().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetSubarea().GetVersion()
+				resp.Subarea = current.GetSubarea()
+			}
 		}
+	} else if isDuplicateKey(err) {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = s.subareaConflictMessage(req.GetMserviceId(), facilityId, req.GetParentSubareaId(), name)
+		resp.ErrorReason = reasonNameInUse
+		err = nil
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
@@ -355,8 +572,13 @@ func (s *invService) DeleteSubarea(ctx context.Context, req *pb.DeleteSubareaReq
 		if rowsAffected == 1 {
 			resp.Version = req.GetVersion() + 1
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetSubarea(ctx, &pb.GetSubareaRequest{MserviceId: req.GetMserviceId(), SubareaId: req.GetSubareaId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetSubarea().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetSubarea().GetVersion()
+				resp.Subarea = current.GetSubarea()
+			}
 		}
 	} else {
 		resp.ErrorCode = 501
@@ -372,12 +594,13 @@ func (s *invService) DeleteSubarea(ctx context.Context, req *pb.DeleteSubareaReq
 func (s *invService) GetSubarea(ctx context.Context, req *pb.GetSubareaRequest) (*pb.GetSubareaResponse, error) {
 	resp := &pb.GetSubareaResponse{}
 
-	sqlstring := `SELECT s.inbSubareaId, s.dtmCreated, s.dtmModified, s.intVersion, s.inbMserviceId, s.inbFacilityId, 
-	s.inbParentSubareaId, s.intPosition, s.intSubareaTypeId, s.chvSubareaName, s.chvJsonData, f.chvFacilityName, t.chvSubareaTypeName 
+	sqlstring := `SELECT s.inbSubareaId, s.dtmCreated, s.dtmModified, s.dtmDeleted, s.bitIsDeleted, s.intVersion, s.inbMserviceId, 
+	s.inbFacilityId, s.inbParentSubareaId, s.intPosition, s.intSubareaTypeId, s.chvSubareaName, s.chvJsonData, f.chvFacilityName, 
+	t.chvSubareaTypeName 
 	FROM tb_Subarea AS s 
 	LEFT JOIN tb_Facility AS f ON s.inbFacilityId = f.inbFacilityId
 	LEFT JOIN tb_SubareaType AS t ON  s.inbMserviceId = t.inbMserviceId AND s.intSubareaTypeId = t.intSubareaTypeId
-	WHERE s.inbMserviceId = ? AND s.inbSubareaId = ? AND s.bitIsDeleted = 0`
+	WHERE s.inbMserviceId = ? AND s.inbSubareaId = ?` + liveClause("s.", req.GetIncludeDeleted())
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -391,17 +614,21 @@ func (s *invService) GetSubarea(ctx context.Context, req *pb.GetSubareaRequest)
 
 	var created string
 	var modified string
+	var deleted string
 	var subarea pb.Subarea
 	var facility sql.NullString
 	var subtype sql.NullString
 
-	err = stmt.QueryRow(req.GetMserviceId(), req.GetSubareaId()).Scan(&subarea.SubareaId, &created, &modified, &subarea.Version, &subarea.MserviceId,
-		&subarea.FacilityId, &subarea.ParentSubareaId, &subarea.Position, &subarea.SubareaTypeId, &subarea.SubareaName,
-		&subarea.JsonData, &facility, &subtype)
+	err = stmt.QueryRow(req.GetMserviceId(), req.GetSubareaId()).Scan(&subarea.SubareaId, &created, &modified, &deleted,
+		&subarea.IsDeleted, &subarea.Version, &subarea.MserviceId, &subarea.FacilityId, &subarea.ParentSubareaId,
+		&subarea.Position, &subarea.SubareaTypeId, &subarea.SubareaName, &subarea.JsonData, &facility, &subtype)
 
 	if err == nil {
 		subarea.Created = dml.DateTimeFromString(created)
 		subarea.Modified = dml.DateTimeFromString(modified)
+		if subarea.IsDeleted {
+			subarea.Deleted = dml.DateTimeFromString(deleted)
+		}
 		if facility.Valid {
 			subarea.FacilityName = facility.String
 		}
@@ -427,17 +654,72 @@ func (s *invService) GetSubarea(ctx context.Context, req *pb.GetSubareaRequest)
 func (s *invService) GetSubareas(ctx context.Context, req *pb.GetSubareasRequest) (*pb.GetSubareasResponse, error) {
 	resp := &pb.GetSubareasResponse{}
 
-	gResp, subareas := s.GetSubareasHelper(req.GetMserviceId(), req.GetFacilityId())
+	page, msg := parsePage(req.GetPageSize(), req.GetPageToken(), "", 0)
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	gResp, subareas := s.GetSubareasHelper(s.db, req.GetMserviceId(), req.GetFacilityId(), page, req.GetIncludeDeleted())
 
 	resp.ErrorCode = gResp.ErrorCode
 	resp.ErrorMessage = gResp.ErrorMessage
 	resp.Subareas = subareas
 
+	if resp.ErrorCode != 0 {
+		return resp, nil
+	}
+
+	if page.more(len(resp.Subareas)) {
+		resp.Subareas = resp.Subareas[:page.size]
+		resp.NextPageToken = page.nextToken(resp.Subareas[page.size-1].GetSubareaId(), nil)
+	}
+
+	if req.GetIncludeTotalCount() {
+		var err error
+		resp.TotalCount, err = s.countRows(`FROM tb_Subarea WHERE inbMserviceId = ? AND inbFacilityId = ?`+
+			liveClause("", req.GetIncludeDeleted()), []interface{}{req.GetMserviceId(), req.GetFacilityId()})
+		if err != nil {
+			level.Error(s.logger).Log("what", "countRows", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+	}
+
 	return resp, nil
 }
 
 // create a new product
 func (s *invService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
+	if req.GetRequestId() != "" {
+		resp := &pb.CreateProductResponse{}
+		s.createOnce(req, resp, func(txs *invService, once proto.Message) createResponse {
+			created, _ := txs.CreateProduct(ctx, once.(*pb.CreateProductRequest))
+			return created
+		})
+
+		return resp, nil
+	}
+
+	resp := &pb.CreateProductResponse{}
+
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.createProduct(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.CreateProductResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to create a new product after checking its json_data.
+func (s *invService) createProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
 	resp := &pb.CreateProductResponse{}
 
 	name := strings.TrimSpace(req.GetProductName())
@@ -447,6 +729,20 @@ func (s *invService) CreateProduct(ctx context.Context, req *pb.CreateProductReq
 		return resp, nil
 	}
 
+	msg, err := s.checkJsonData(req.GetMserviceId(), "product", req.GetJsonData())
+	if err != nil {
+		level.Error(s.logger).Log("what", "checkJsonData", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
 	sqlstring := `INSERT INTO tb_Product (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, 
 		chvSku, chvProductName, chvComment, chvJsonData) VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?)`
 
@@ -485,6 +781,39 @@ func (s *invService) CreateProduct(ctx context.Context, req *pb.CreateProductReq
 func (s *invService) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
 	resp := &pb.UpdateProductResponse{}
 
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.updateProduct(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.UpdateProductResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to update an existing product after checking its json_data.
+func (s *invService) updateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
+	resp := &pb.UpdateProductResponse{}
+
+	if hasUpdateMask(req.GetUpdateMask()) {
+		current, _ := s.GetProduct(ctx, &pb.GetProductRequest{MserviceId: req.GetMserviceId(), ProductId: req.GetProductId()})
+		if current.GetErrorCode() != 0 {
+			resp.ErrorCode = current.GetErrorCode()
+			resp.ErrorMessage = current.GetErrorMessage()
+			return resp, nil
+		}
+
+		msg := applyUpdateMask(req.GetUpdateMask(), req, current.GetProduct(), "product_id")
+		if msg != "" {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = msg
+			return resp, nil
+		}
+	}
+
 	name := strings.TrimSpace(req.GetProductName())
 	if name == "" {
 		resp.ErrorCode = 510
@@ -492,6 +821,20 @@ func (s *invService) UpdateProduct(ctx context.Context, req *pb.UpdateProductReq
 		return resp, nil
 	}
 
+	msg, err := s.checkJsonData(req.GetMserviceId(), "product", req.GetJsonData())
+	if err != nil {
+		level.Error(s.logger).Log("what", "checkJsonData", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
 	sqlstring := `UPDATE tb_Product SET dtmModified = NOW(), intVersion = intVersion + 1, chvSku = ?, chvProductName = ?, 
 	chvComment = ?, chvJsonData = ?
 	WHERE inbProductId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = 0`
@@ -513,8 +856,13 @@ func (s *invService) UpdateProduct(ctx context.Context, req *pb.UpdateProductReq
 		if rowsAffected == 1 {
 			resp.Version = req.GetVersion() + 1
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetProduct(ctx, &pb.GetProductRequest{MserviceId: req.GetMserviceId(), ProductId: req.GetProductId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetProduct().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetProduct().GetVersion()
+				resp.Product = current.GetProduct()
+			}
 		}
 	} else {
 		resp.ErrorCode = 501
@@ -530,6 +878,23 @@ func (s *invService) UpdateProduct(ctx context.Context, req *pb.UpdateProductReq
 func (s *invService) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
 	resp := &pb.DeleteProductResponse{}
 
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.deleteProduct(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.DeleteProductResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to delete an existing product, once no live records refer to it or they have been reassigned.
+func (s *invService) deleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	resp := &pb.DeleteProductResponse{}
+
 	sqlstring := `UPDATE tb_Product SET dtmDeleted = NOW(), bitIsDeleted = 1, intVersion = intVersion + 1
 	WHERE inbProductId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = 0`
 
@@ -548,10 +913,27 @@ func (s *invService) DeleteProduct(ctx context.Context, req *pb.DeleteProductReq
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
 		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
+			reassigned, msg, reason, err := s.releaseProduct(req.GetMserviceId(), req.GetProductId(), req.GetReassignTo())
+			if err != nil {
+				level.Error(s.logger).Log("what", "releaseProduct", "error", err)
+				resp.ErrorCode = 500
+				resp.ErrorMessage = err.Error()
+			} else if msg != "" {
+				resp.ErrorCode = 510
+				resp.ErrorMessage = msg
+				resp.ErrorReason = reason
+			} else {
+				resp.Version = req.GetVersion() + 1
+				resp.Reassigned = reassigned
+			}
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetProduct(ctx, &pb.GetProductRequest{MserviceId: req.GetMserviceId(), ProductId: req.GetProductId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetProduct().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetProduct().GetVersion()
+				resp.Product = current.GetProduct()
+			}
 		}
 	} else {
 		resp.ErrorCode = 501
@@ -567,9 +949,9 @@ func (s *invService) DeleteProduct(ctx context.Context, req *pb.DeleteProductReq
 func (s *invService) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductResponse, error) {
 	resp := &pb.GetProductResponse{}
 
-	sqlstring := `SELECT inbProductId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvSku, chvProductName, 
-	chvComment, chvJsonData
-	FROM tb_Product WHERE inbProductId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+	sqlstring := `SELECT inbProductId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvSku, 
+	chvProductName, chvComment, chvJsonData
+	FROM tb_Product WHERE inbProductId = ? AND inbMserviceId = ?` + liveClause("", req.GetIncludeDeleted())
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -583,14 +965,19 @@ func (s *invService) GetProduct(ctx context.Context, req *pb.GetProductRequest)
 
 	var created string
 	var modified string
+	var deleted string
 	var product pb.Product
 
-	err = stmt.QueryRow(req.GetProductId(), req.GetMserviceId()).Scan(&product.ProductId, &created, &modified, &product.Version,
-		&product.MserviceId, &product.Sku, &product.ProductName, &product.Comment, &product.JsonData)
+	err = stmt.QueryRow(req.GetProductId(), req.GetMserviceId()).Scan(&product.ProductId, &created, &modified, &deleted,
+		&product.IsDeleted, &product.Version, &product.MserviceId, &product.Sku, &product.ProductName, &product.Comment,
+		&product.JsonData)
 
 	if err == nil {
 		product.Created = dml.DateTimeFromString(created)
 		product.Modified = dml.DateTimeFromString(modified)
+		if product.IsDeleted {
+			product.Deleted = dml.DateTimeFromString(deleted)
+		}
 		resp.Product = &product
 		resp.ErrorCode = 0
 	} else if err == sql.ErrNoRows {
@@ -610,9 +997,30 @@ func (s *invService) GetProduct(ctx context.Context, req *pb.GetProductRequest)
 func (s *invService) GetProducts(ctx context.Context, req *pb.GetProductsRequest) (*pb.GetProductsResponse, error) {
 	resp := &pb.GetProductsResponse{}
 
-	sqlstring := `SELECT inbProductId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvSku, chvProductName, 
-	chvComment, chvJsonData
-	FROM tb_Product WHERE inbMserviceId = ? AND bitIsDeleted = 0`
+	indexes, err := s.jsonIndexColumns(req.GetMserviceId(), "product", req.GetFilter())
+	if err != nil {
+		level.Error(s.logger).Log("what", "jsonIndexColumns", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	opts, msg := parseListOptions(req.GetFilter(), req.GetOrderBy(), req.GetPageSize(), req.GetPageToken(),
+		productFields, indexes)
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	fromWhere := `FROM tb_Product WHERE inbMserviceId = ?` + liveClause("", req.GetIncludeDeleted())
+	fromWhere += opts.where
+	args := append([]interface{}{req.GetMserviceId()}, opts.args...)
+	pageClause, pageArgs := opts.page.clause("inbProductId", opts.order, args)
+
+	sqlstring := `SELECT inbProductId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvSku, 
+	chvProductName, chvComment, chvJsonData
+	` + fromWhere + pageClause
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -624,7 +1032,7 @@ func (s *invService) GetProducts(ctx context.Context, req *pb.GetProductsRequest
 
 	defer stmt.Close()
 
-	rows, err := stmt.Query(req.GetMserviceId())
+	rows, err := stmt.Query(pageArgs...)
 
 	if err != nil {
 		level.Error(s.logger).Log("what", "Query", "error", err)
@@ -637,9 +1045,10 @@ func (s *invService) GetProducts(ctx context.Context, req *pb.GetProductsRequest
 	for rows.Next() {
 		var created string
 		var modified string
+		var deleted string
 		var product pb.Product
 
-		err := rows.Scan(&product.ProductId, &created, &modified, &product.Version,
+		err := rows.Scan(&product.ProductId, &created, &modified, &deleted, &product.IsDeleted, &product.Version,
 			&product.MserviceId, &product.Sku, &product.ProductName, &product.Comment, &product.JsonData)
 
 		if err != nil {
@@ -651,16 +1060,84 @@ func (s *invService) GetProducts(ctx context.Context, req *pb.GetProductsRequest
 
 		product.Created = dml.DateTimeFromString(created)
 		product.Modified = dml.DateTimeFromString(modified)
+		if product.IsDeleted {
+			product.Deleted = dml.DateTimeFromString(deleted)
+		}
 		resp.Products = append(resp.Products, &product)
 	}
 
+	if opts.page.more(len(resp.Products)) {
+		resp.Products = resp.Products[:opts.page.size]
+		last := resp.Products[opts.page.size-1]
+		resp.NextPageToken = opts.page.nextToken(last.GetProductId(), productKeys(last, opts.order))
+	}
+
+	if req.GetIncludeTotalCount() {
+		resp.TotalCount, err = s.countRows(fromWhere, args)
+		if err != nil {
+			level.Error(s.logger).Log("what", "countRows", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+	}
+
 	return resp, nil
 }
 
 // create a new inventory item
 func (s *invService) CreateInventoryItem(ctx context.Context, req *pb.CreateInventoryItemRequest) (*pb.CreateInventoryItemResponse, error) {
+	if req.GetRequestId() != "" {
+		resp := &pb.CreateInventoryItemResponse{}
+		s.createOnce(req, resp, func(txs *invService, once proto.Message) createResponse {
+			created, _ := txs.CreateInventoryItem(ctx, once.(*pb.CreateInventoryItemRequest))
+			return created
+		})
+
+		return resp, nil
+	}
+
 	resp := &pb.CreateInventoryItemResponse{}
 
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.createInventoryItem(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.CreateInventoryItemResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to create a new inventory item after checking its references, and record its create event.
+func (s *invService) createInventoryItem(ctx context.Context, req *pb.CreateInventoryItemRequest) (*pb.CreateInventoryItemResponse, error) {
+	resp := &pb.CreateInventoryItemResponse{}
+
+	msg, err := s.checkItemReferences(s.db, req.GetMserviceId(), req.GetSubareaId(), req.GetItemTypeId(), req.GetProductId())
+	if (err == nil) && (msg == "") {
+		msg, err = s.checkItemPlacement(s.db, req.GetMserviceId(), req.GetSubareaId(), req.GetItemTypeId())
+		resp.ErrorReason = reasonFor(msg, reasonTypeRule)
+	}
+	if (err == nil) && (msg == "") {
+		msg, err = s.checkJsonData(req.GetMserviceId(), "inventoryitem", req.GetJsonData())
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "checkItemReferences", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
 	sqlstring := `INSERT INTO tb_InventoryItem (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, inbSubareaId, 
 		intItemTypeId, intQuantity, chvSerialNumber, inbProductId, chvJsonData) 
 		VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?)`
@@ -688,6 +1165,13 @@ func (s *invService) CreateInventoryItem(ctx context.Context, req *pb.CreateInve
 
 		resp.InventoryItemId = itemId
 		resp.Version = 1
+
+		err = recordItemEvent(s.db, req.GetMserviceId(), itemId, itemEventCreate, 0, 0)
+		if err != nil {
+			level.Error(s.logger).Log("what", "recordItemEvent", "error", err)
+			resp.ErrorCode = 501
+			resp.ErrorMessage = err.Error()
+		}
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
@@ -702,6 +1186,69 @@ func (s *invService) CreateInventoryItem(ctx context.Context, req *pb.CreateInve
 func (s *invService) UpdateInventoryItem(ctx context.Context, req *pb.UpdateInventoryItemRequest) (*pb.UpdateInventoryItemResponse, error) {
 	resp := &pb.UpdateInventoryItemResponse{}
 
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.updateInventoryItem(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.UpdateInventoryItemResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to update an existing inventory item after checking its references, and record its update event.
+func (s *invService) updateInventoryItem(ctx context.Context, req *pb.UpdateInventoryItemRequest) (*pb.UpdateInventoryItemResponse, error) {
+	resp := &pb.UpdateInventoryItemResponse{}
+
+	if hasUpdateMask(req.GetUpdateMask()) {
+		current, _ := s.GetInventoryItem(ctx, &pb.GetInventoryItemRequest{MserviceId: req.GetMserviceId(), InventoryItemId: req.GetInventoryItemId()})
+		if current.GetErrorCode() != 0 {
+			resp.ErrorCode = current.GetErrorCode()
+			resp.ErrorMessage = current.GetErrorMessage()
+			return resp, nil
+		}
+
+		msg := applyUpdateMask(req.GetUpdateMask(), req, current.GetInventoryItem(), "inventory_item_id")
+		if msg != "" {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = msg
+			return resp, nil
+		}
+	}
+
+	msg, err := s.checkItemReferences(s.db, req.GetMserviceId(), req.GetSubareaId(), req.GetItemTypeId(), req.GetProductId())
+	if (err == nil) && (msg == "") {
+		msg, err = s.checkItemPlacement(s.db, req.GetMserviceId(), req.GetSubareaId(), req.GetItemTypeId())
+		resp.ErrorReason = reasonFor(msg, reasonTypeRule)
+	}
+	if (err == nil) && (msg == "") {
+		msg, err = s.checkJsonData(req.GetMserviceId(), "inventoryitem", req.GetJsonData())
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "checkItemReferences", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	prevSubareaId, prevProductId, err := getItemPlacement(s.db, req.GetMserviceId(), req.GetInventoryItemId())
+	if err != nil {
+		level.Error(s.logger).Log("what", "getItemPlacement", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
 	sqlstring := `UPDATE tb_InventoryItem SET dtmModified = NOW(), intVersion = intVersion + 1, inbSubareaId = ?, intItemTypeId = ?, 
 	intQuantity = ?, chvSerialNumber = ?, inbProductId = ?, chvJsonData = ? WHERE inbInventoryItemId= ? AND inbMserviceId = ? AND intVersion = ? 
 	AND bitIsDeleted = 0`
@@ -723,9 +1270,21 @@ func (s *invService) UpdateInventoryItem(ctx context.Context, req *pb.UpdateInve
 		rowsAffected, _ := res.RowsAffected()
 		if rowsAffected == 1 {
 			resp.Version = req.GetVersion() + 1
+
+			err = recordItemEvent(s.db, req.GetMserviceId(), req.GetInventoryItemId(), itemEventUpdate, prevSubareaId, prevProductId)
+			if err != nil {
+				level.Error(s.logger).Log("what", "recordItemEvent", "error", err)
+				resp.ErrorCode = 501
+				resp.ErrorMessage = err.Error()
+			}
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetInventoryItem(ctx, &pb.GetInventoryItemRequest{MserviceId: req.GetMserviceId(), InventoryItemId: req.GetInventoryItemId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetInventoryItem().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetInventoryItem().GetVersion()
+				resp.InventoryItem = current.GetInventoryItem()
+			}
 		}
 	} else {
 		resp.ErrorCode = 501
@@ -741,6 +1300,23 @@ func (s *invService) UpdateInventoryItem(ctx context.Context, req *pb.UpdateInve
 func (s *invService) DeleteInventoryItem(ctx context.Context, req *pb.DeleteInventoryItemRequest) (*pb.DeleteInventoryItemResponse, error) {
 	resp := &pb.DeleteInventoryItemResponse{}
 
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.deleteInventoryItem(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.DeleteInventoryItemResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to delete an existing inventory item and record its delete event.
+func (s *invService) deleteInventoryItem(ctx context.Context, req *pb.DeleteInventoryItemRequest) (*pb.DeleteInventoryItemResponse, error) {
+	resp := &pb.DeleteInventoryItemResponse{}
+
 	sqlstring := `UPDATE tb_InventoryItem SET dtmDeleted = NOW(), bitIsDeleted = 1, intVersion = intVersion + 1
 	WHERE inbInventoryItemId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = 0`
 
@@ -759,9 +1335,21 @@ func (s *invService) DeleteInventoryItem(ctx context.Context, req *pb.DeleteInve
 		rowsAffected, _ := res.RowsAffected()
 		if rowsAffected == 1 {
 			resp.Version = req.GetVersion() + 1
+
+			err = recordItemEvent(s.db, req.GetMserviceId(), req.GetInventoryItemId(), itemEventDelete, 0, 0)
+			if err != nil {
+				level.Error(s.logger).Log("what", "recordItemEvent", "error", err)
+				resp.ErrorCode = 501
+				resp.ErrorMessage = err.Error()
+			}
 		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
+			current, _ := s.GetInventoryItem(ctx, &pb.GetInventoryItemRequest{MserviceId: req.GetMserviceId(), InventoryItemId: req.GetInventoryItemId()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetInventoryItem().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetInventoryItem().GetVersion()
+				resp.InventoryItem = current.GetInventoryItem()
+			}
 		}
 	} else {
 		resp.ErrorCode = 501
@@ -777,13 +1365,13 @@ func (s *invService) DeleteInventoryItem(ctx context.Context, req *pb.DeleteInve
 func (s *invService) GetInventoryItem(ctx context.Context, req *pb.GetInventoryItemRequest) (*pb.GetInventoryItemResponse, error) {
 	resp := &pb.GetInventoryItemResponse{}
 
-	sqlstring := `SELECT i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.intVersion, i.inbMserviceId,
-	i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId, i.chvJsonData,
+	sqlstring := `SELECT i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.dtmDeleted, i.bitIsDeleted, i.intVersion,
+	i.inbMserviceId, i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId, i.chvJsonData,
 	t.chvItemTypeName, p.chvProductName
 	FROM tb_InventoryItem AS i
 	LEFT JOIN tb_ItemType as t ON  i.inbMserviceId = t.inbMserviceId AND i.intItemTypeId = t.intItemTypeId
 	LEFT JOIN tb_Product as p ON i.inbProductId = p.inbProductId
-	WHERE i.inbInventoryItemId = ? AND i.inbMserviceId = ? AND i.bitIsDeleted = 0`
+	WHERE i.inbInventoryItemId = ? AND i.inbMserviceId = ?` + liveClause("i.", req.GetIncludeDeleted())
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -797,17 +1385,21 @@ func (s *invService) GetInventoryItem(ctx context.Context, req *pb.GetInventoryI
 
 	var created string
 	var modified string
+	var deleted string
 	var item pb.InventoryItem
 	var typeName sql.NullString
 	var productName sql.NullString
 
 	err = stmt.QueryRow(req.GetInventoryItemId(), req.GetMserviceId()).Scan(&item.InventoryItemId, &created, &modified,
-		&item.Version, &item.MserviceId, &item.SubareaId, &item.ItemTypeId, &item.Quantity, &item.SerialNumber,
-		&item.ProductId, &item.JsonData, &typeName, &productName)
+		&deleted, &item.IsDeleted, &item.Version, &item.MserviceId, &item.SubareaId, &item.ItemTypeId, &item.Quantity,
+		&item.SerialNumber, &item.ProductId, &item.JsonData, &typeName, &productName)
 
 	if err == nil {
 		item.Created = dml.DateTimeFromString(created)
 		item.Modified = dml.DateTimeFromString(modified)
+		if item.IsDeleted {
+			item.Deleted = dml.DateTimeFromString(deleted)
+		}
 		if typeName.Valid {
 			item.ItemTypeName = typeName.String
 		}
@@ -833,13 +1425,34 @@ func (s *invService) GetInventoryItem(ctx context.Context, req *pb.GetInventoryI
 func (s *invService) GetInventoryItemsByProduct(ctx context.Context, req *pb.GetInventoryItemsByProductRequest) (*pb.GetInventoryItemsByProductResponse, error) {
 	resp := &pb.GetInventoryItemsByProductResponse{}
 
-	sqlstring := `SELECT i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.intVersion, i.inbMserviceId,
-	i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId, i.chvJsonData,
-	t.chvItemTypeName, p.chvProductName
-	FROM tb_InventoryItem AS i
+	indexes, err := s.jsonIndexColumns(req.GetMserviceId(), "inventoryitem", req.GetFilter())
+	if err != nil {
+		level.Error(s.logger).Log("what", "jsonIndexColumns", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	opts, msg := parseListOptions(req.GetFilter(), req.GetOrderBy(), req.GetPageSize(), req.GetPageToken(),
+		inventoryItemFields, indexes)
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	fromWhere := `FROM tb_InventoryItem AS i
 	LEFT JOIN tb_ItemType as t ON  i.inbMserviceId = t.inbMserviceId AND i.intItemTypeId = t.intItemTypeId
 	LEFT JOIN tb_Product as p ON i.inbProductId = p.inbProductId
-	WHERE i.inbProductId = ? AND i.inbMserviceId = ? AND i.bitIsDeleted = 0`
+	WHERE i.inbProductId = ? AND i.inbMserviceId = ?` + liveClause("i.", req.GetIncludeDeleted())
+	fromWhere += opts.where
+	args := append([]interface{}{req.GetProductId(), req.GetMserviceId()}, opts.args...)
+	pageClause, pageArgs := opts.page.clause("i.inbInventoryItemId", opts.order, args)
+
+	sqlstring := `SELECT i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.dtmDeleted, i.bitIsDeleted, i.intVersion,
+	i.inbMserviceId, i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId, i.chvJsonData,
+	t.chvItemTypeName, p.chvProductName
+	` + fromWhere + pageClause
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -851,7 +1464,7 @@ func (s *invService) GetInventoryItemsByProduct(ctx context.Context, req *pb.Get
 
 	defer stmt.Close()
 
-	rows, err := stmt.Query(req.GetProductId(), req.GetMserviceId())
+	rows, err := stmt.Query(pageArgs...)
 
 	if err != nil {
 		level.Error(s.logger).Log("what", "Query", "error", err)
@@ -864,11 +1477,12 @@ func (s *invService) GetInventoryItemsByProduct(ctx context.Context, req *pb.Get
 	for rows.Next() {
 		var created string
 		var modified string
+		var deleted string
 		var item pb.InventoryItem
 		var typeName sql.NullString
 		var productName sql.NullString
 
-		err := rows.Scan(&item.InventoryItemId, &created, &modified,
+		err := rows.Scan(&item.InventoryItemId, &created, &modified, &deleted, &item.IsDeleted,
 			&item.Version, &item.MserviceId, &item.SubareaId, &item.ItemTypeId, &item.Quantity, &item.SerialNumber,
 			&item.ProductId, &item.JsonData, &typeName, &productName)
 		if err != nil {
@@ -880,6 +1494,9 @@ func (s *invService) GetInventoryItemsByProduct(ctx context.Context, req *pb.Get
 
 		item.Created = dml.DateTimeFromString(created)
 		item.Modified = dml.DateTimeFromString(modified)
+		if item.IsDeleted {
+			item.Deleted = dml.DateTimeFromString(deleted)
+		}
 		if typeName.Valid {
 			item.ItemTypeName = typeName.String
 		}
@@ -890,6 +1507,22 @@ func (s *invService) GetInventoryItemsByProduct(ctx context.Context, req *pb.Get
 		resp.InventoryItems = append(resp.InventoryItems, &item)
 	}
 
+	if opts.page.more(len(resp.InventoryItems)) {
+		resp.InventoryItems = resp.InventoryItems[:opts.page.size]
+		last := resp.InventoryItems[opts.page.size-1]
+		resp.NextPageToken = opts.page.nextToken(last.GetInventoryItemId(), inventoryItemKeys(last, opts.order))
+	}
+
+	if req.GetIncludeTotalCount() {
+		resp.TotalCount, err = s.countRows(fromWhere, args)
+		if err != nil {
+			level.Error(s.logger).Log("what", "countRows", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+	}
+
 	return resp, nil
 }
 
@@ -897,13 +1530,34 @@ func (s *invService) GetInventoryItemsByProduct(ctx context.Context, req *pb.Get
 func (s *invService) GetInventoryItemsBySubarea(ctx context.Context, req *pb.GetInventoryItemsBySubareaRequest) (*pb.GetInventoryItemsBySubareaResponse, error) {
 	resp := &pb.GetInventoryItemsBySubareaResponse{}
 
-	sqlstring := `SELECT i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.intVersion, i.inbMserviceId,
-	i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId, i.chvJsonData, 
-	t.chvItemTypeName, p.chvProductName
-	FROM tb_InventoryItem AS i
+	indexes, err := s.jsonIndexColumns(req.GetMserviceId(), "inventoryitem", req.GetFilter())
+	if err != nil {
+		level.Error(s.logger).Log("what", "jsonIndexColumns", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	opts, msg := parseListOptions(req.GetFilter(), req.GetOrderBy(), req.GetPageSize(), req.GetPageToken(),
+		inventoryItemFields, indexes)
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	fromWhere := `FROM tb_InventoryItem AS i
 	LEFT JOIN tb_ItemType as t ON  i.inbMserviceId = t.inbMserviceId AND i.intItemTypeId = t.intItemTypeId
 	LEFT JOIN tb_Product as p ON i.inbProductId = p.inbProductId
-	WHERE i.inbSubareaId = ? AND i.inbMserviceId = ? AND i.bitIsDeleted = 0`
+	WHERE i.inbSubareaId = ? AND i.inbMserviceId = ?` + liveClause("i.", req.GetIncludeDeleted())
+	fromWhere += opts.where
+	args := append([]interface{}{req.GetSubareaId(), req.GetMserviceId()}, opts.args...)
+	pageClause, pageArgs := opts.page.clause("i.inbInventoryItemId", opts.order, args)
+
+	sqlstring := `SELECT i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.dtmDeleted, i.bitIsDeleted, i.intVersion,
+	i.inbMserviceId, i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId, i.chvJsonData,
+	t.chvItemTypeName, p.chvProductName
+	` + fromWhere + pageClause
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -915,7 +1569,7 @@ func (s *invService) GetInventoryItemsBySubarea(ctx context.Context, req *pb.Get
 
 	defer stmt.Close()
 
-	rows, err := stmt.Query(req.GetSubareaId(), req.GetMserviceId())
+	rows, err := stmt.Query(pageArgs...)
 
 	if err != nil {
 		level.Error(s.logger).Log("what", "Query", "error", err)
@@ -928,11 +1582,12 @@ func (s *invService) GetInventoryItemsBySubarea(ctx context.Context, req *pb.Get
 	for rows.Next() {
 		var created string
 		var modified string
+		var deleted string
 		var item pb.InventoryItem
 		var typeName sql.NullString
 		var productName sql.NullString
 
-		err := rows.Scan(&item.InventoryItemId, &created, &modified,
+		err := rows.Scan(&item.InventoryItemId, &created, &modified, &deleted, &item.IsDeleted,
 			&item.Version, &item.MserviceId, &item.SubareaId, &item.ItemTypeId, &item.Quantity, &item.SerialNumber,
 			&item.ProductId, &item.JsonData, &typeName, &productName)
 		if err != nil {
@@ -944,6 +1599,9 @@ func (s *invService) GetInventoryItemsBySubarea(ctx context.Context, req *pb.Get
 
 		item.Created = dml.DateTimeFromString(created)
 		item.Modified = dml.DateTimeFromString(modified)
+		if item.IsDeleted {
+			item.Deleted = dml.DateTimeFromString(deleted)
+		}
 		if typeName.Valid {
 			item.ItemTypeName = typeName.String
 		}
@@ -954,6 +1612,22 @@ func (s *invService) GetInventoryItemsBySubarea(ctx context.Context, req *pb.Get
 		resp.InventoryItems = append(resp.InventoryItems, &item)
 	}
 
+	if opts.page.more(len(resp.InventoryItems)) {
+		resp.InventoryItems = resp.InventoryItems[:opts.page.size]
+		last := resp.InventoryItems[opts.page.size-1]
+		resp.NextPageToken = opts.page.nextToken(last.GetInventoryItemId(), inventoryItemKeys(last, opts.order))
+	}
+
+	if req.GetIncludeTotalCount() {
+		resp.TotalCount, err = s.countRows(fromWhere, args)
+		if err != nil {
+			level.Error(s.logger).Log("what", "countRows", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+	}
+
 	return resp, nil
 }
 
@@ -961,14 +1635,35 @@ func (s *invService) GetInventoryItemsBySubarea(ctx context.Context, req *pb.Get
 func (s *invService) GetInventoryItemsByFacility(ctx context.Context, req *pb.GetInventoryItemsByFacilityRequest) (*pb.GetInventoryItemsByFacilityResponse, error) {
 	resp := &pb.GetInventoryItemsByFacilityResponse{}
 
-	sqlstring := `SELECT i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.intVersion, i.inbMserviceId,
-	i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId, i.chvJsonData,
-	t.chvItemTypeName, p.chvProductName
-	FROM tb_InventoryItem AS i
+	indexes, err := s.jsonIndexColumns(req.GetMserviceId(), "inventoryitem", req.GetFilter())
+	if err != nil {
+		level.Error(s.logger).Log("what", "jsonIndexColumns", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	opts, msg := parseListOptions(req.GetFilter(), req.GetOrderBy(), req.GetPageSize(), req.GetPageToken(),
+		inventoryItemFields, indexes)
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	fromWhere := `FROM tb_InventoryItem AS i
 	JOIN tb_Subarea AS a ON i.inbSubareaId = a.inbSubareaId
 	LEFT JOIN tb_ItemType as t ON  i.inbMserviceId = t.inbMserviceId AND i.intItemTypeId = t.intItemTypeId
 	LEFT JOIN tb_Product as p ON i.inbProductId = p.inbProductId
-	WHERE a.inbFacilityId = ? AND i.inbMserviceId = ? AND i.bitIsDeleted = 0`
+	WHERE a.inbFacilityId = ? AND i.inbMserviceId = ?` + liveClause("i.", req.GetIncludeDeleted())
+	fromWhere += opts.where
+	args := append([]interface{}{req.GetFacilityId(), req.GetMserviceId()}, opts.args...)
+	pageClause, pageArgs := opts.page.clause("i.inbInventoryItemId", opts.order, args)
+
+	sqlstring := `SELECT i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.dtmDeleted, i.bitIsDeleted, i.intVersion,
+	i.inbMserviceId, i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId, i.chvJsonData,
+	t.chvItemTypeName, p.chvProductName
+	` + fromWhere + pageClause
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -980,7 +1675,7 @@ func (s *invService) GetInventoryItemsByFacility(ctx context.Context, req *pb.Ge
 
 	defer stmt.Close()
 
-	rows, err := stmt.Query(req.GetFacilityId(), req.GetMserviceId())
+	rows, err := stmt.Query(pageArgs...)
 
 	if err != nil {
 		level.Error(s.logger).Log("what", "Query", "error", err)
@@ -993,11 +1688,12 @@ func (s *invService) GetInventoryItemsByFacility(ctx context.Context, req *pb.Ge
 	for rows.Next() {
 		var created string
 		var modified string
+		var deleted string
 		var item pb.InventoryItem
 		var typeName sql.NullString
 		var productName sql.NullString
 
-		err := rows.Scan(&item.InventoryItemId, &created, &modified,
+		err := rows.Scan(&item.InventoryItemId, &created, &modified, &deleted, &item.IsDeleted,
 			&item.Version, &item.MserviceId, &item.SubareaId, &item.ItemTypeId, &item.Quantity, &item.SerialNumber,
 			&item.ProductId, &item.JsonData, &typeName, &productName)
 		if err != nil {
@@ -1009,6 +1705,9 @@ func (s *invService) GetInventoryItemsByFacility(ctx context.Context, req *pb.Ge
 
 		item.Created = dml.DateTimeFromString(created)
 		item.Modified = dml.DateTimeFromString(modified)
+		if item.IsDeleted {
+			item.Deleted = dml.DateTimeFromString(deleted)
+		}
 		if typeName.Valid {
 			item.ItemTypeName = typeName.String
 		}
@@ -1019,6 +1718,22 @@ func (s *invService) GetInventoryItemsByFacility(ctx context.Context, req *pb.Ge
 		resp.InventoryItems = append(resp.InventoryItems, &item)
 	}
 
+	if opts.page.more(len(resp.InventoryItems)) {
+		resp.InventoryItems = resp.InventoryItems[:opts.page.size]
+		last := resp.InventoryItems[opts.page.size-1]
+		resp.NextPageToken = opts.page.nextToken(last.GetInventoryItemId(), inventoryItemKeys(last, opts.order))
+	}
+
+	if req.GetIncludeTotalCount() {
+		resp.TotalCount, err = s.countRows(fromWhere, args)
+		if err != nil {
+			level.Error(s.logger).Log("what", "countRows", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+	}
+
 	return resp, nil
 }
 
@@ -1035,6 +1750,16 @@ func (s *invService) GetServerVersion(ctx context.Context, req *pb.GetServerVers
 
 // create an entity schema
 func (s *invService) CreateEntitySchema(ctx context.Context, req *pb.CreateEntitySchemaRequest) (*pb.CreateEntitySchemaResponse, error) {
+	if req.GetRequestId() != "" {
+		resp := &pb.CreateEntitySchemaResponse{}
+		s.createOnce(req, resp, func(txs *invService, once proto.Message) createResponse {
+			created, _ := txs.CreateEntitySchema(ctx, once.(*pb.CreateEntitySchemaRequest))
+			return created
+		})
+
+		return resp, nil
+	}
+
 	resp := &pb.CreateEntitySchemaResponse{}
 	var err error
 
@@ -1043,8 +1768,14 @@ func (s *invService) CreateEntitySchema(ctx context.Context, req *pb.CreateEntit
 	_, found := supportedEntities[entityName]
 
 	if !found {
-		resp.ErrorCode = 401
-		resp.ErrorMessage = "schema entity name not supported"
+		resp.ErrorCode = 510
+		resp.ErrorMessage = unsupportedEntityMessage
+		return resp, nil
+	}
+
+	if _, msg := compileJsonSchema(entityName, req.GetJsonSchema()); msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
 		return resp, nil
 	}
 
@@ -1077,10 +1808,60 @@ func (s *invService) CreateEntitySchema(ctx context.Context, req *pb.CreateEntit
 // update an entity schema
 func (s *invService) UpdateEntitySchema(ctx context.Context, req *pb.UpdateEntitySchemaRequest) (*pb.UpdateEntitySchemaResponse, error) {
 	resp := &pb.UpdateEntitySchemaResponse{}
+
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.updateEntitySchema(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.UpdateEntitySchemaResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to update an entity schema and, if asked, check the existing json_data of the entity against it. The
+// schema row stays locked until the transaction ends, and every write of json_data share locks it in its own
+// transaction until that write commits, so no write of the entity can be checked against the old schema after the
+// scan.
+func (s *invService) updateEntitySchema(ctx context.Context, req *pb.UpdateEntitySchemaRequest) (*pb.UpdateEntitySchemaResponse, error) {
+	resp := &pb.UpdateEntitySchemaResponse{}
+
+	if hasUpdateMask(req.GetUpdateMask()) {
+		current, _ := s.GetEntitySchema(ctx, &pb.GetEntitySchemaRequest{MserviceId: req.GetMserviceId(), EntityName: req.GetEntityName()})
+		if current.GetErrorCode() != 0 {
+			resp.ErrorCode = current.GetErrorCode()
+			resp.ErrorMessage = current.GetErrorMessage()
+			return resp, nil
+		}
+
+		msg := applyUpdateMask(req.GetUpdateMask(), req, current.GetEntitySchema(), "entity_name")
+		if msg != "" {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = msg
+			return resp, nil
+		}
+	}
+
 	var err error
 
 	entityName := strings.ToLower(req.GetEntityName())
 
+	if _, found := supportedEntities[entityName]; !found {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = unsupportedEntityMessage
+		return resp, nil
+	}
+
+	schema, msg := compileJsonSchema(entityName, req.GetJsonSchema())
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
 	sqlstring := `UPDATE tb_EntitySchema SET dtmModified = NOW(), intVersion = ?, chvJsonSchema = ?  
 	WHERE inbMserviceId = ? AND chvEntityName = ? AND intVersion = ? AND bitIsDeleted = 0`
 
@@ -1094,10 +1875,35 @@ func (s *invService) UpdateEntitySchema(ctx context.Context, req *pb.UpdateEntit
 
 	defer stmt.Close()
 
-	_, err = stmt.Exec(req.GetVersion()+1, req.GetJsonSchema(), req.GetMserviceId(), entityName, req.GetVersion())
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetJsonSchema(), req.GetMserviceId(), entityName, req.GetVersion())
 
 	if err == nil {
-		resp.Version = req.GetVersion() + 1
+		rowsAffected, _ := res.RowsAffected()
+		if (rowsAffected == 1) && (req.GetCheckExisting() || req.GetForce()) {
+			resp.NonconformingCount, resp.NonconformingRecords, err = s.scanJsonData(req.GetMserviceId(), entityName, schema)
+			if err != nil {
+				level.Error(s.logger).Log("what", "scanJsonData", "error", err)
+				resp.ErrorCode = 500
+				resp.ErrorMessage = err.Error()
+			} else if (resp.NonconformingCount > 0) && !req.GetForce() {
+				resp.ErrorCode = 510
+				resp.ErrorMessage = fmt.Sprintf("%d live %s records do not match the new schema, give force to update it anyway",
+					resp.NonconformingCount, entityName)
+				resp.ErrorReason = reasonNonconformingData
+			} else {
+				resp.Version = req.GetVersion() + 1
+			}
+		} else if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			current, _ := s.GetEntitySchema(ctx, &pb.GetEntitySchemaRequest{MserviceId: req.GetMserviceId(), EntityName: req.GetEntityName()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetEntitySchema().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetEntitySchema().GetVersion()
+				resp.EntitySchema = current.GetEntitySchema()
+			}
+		}
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
@@ -1105,7 +1911,7 @@ func (s *invService) UpdateEntitySchema(ctx context.Context, req *pb.UpdateEntit
 		err = nil
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // delete an entity schema
@@ -1128,10 +1934,21 @@ func (s *invService) DeleteEntitySchema(ctx context.Context, req *pb.DeleteEntit
 
 	defer stmt.Close()
 
-	_, err = stmt.Exec(req.GetVersion()+1, req.GetMserviceId(), entityName, req.GetVersion())
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetMserviceId(), entityName, req.GetVersion())
 
 	if err == nil {
-		resp.Version = req.GetVersion() + 1
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			current, _ := s.GetEntitySchema(ctx, &pb.GetEntitySchemaRequest{MserviceId: req.GetMserviceId(), EntityName: req.GetEntityName()})
+			resp.ErrorCode, resp.ErrorMessage = versionConflict(current.GetErrorCode(), current.GetErrorMessage(),
+				current.GetEntitySchema().GetVersion())
+			if resp.ErrorCode == 409 {
+				resp.Version = current.GetEntitySchema().GetVersion()
+				resp.EntitySchema = current.GetEntitySchema()
+			}
+		}
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
@@ -1173,6 +1990,10 @@ func (s *invService) GetEntitySchema(ctx context.Context, req *pb.GetEntitySchem
 		entity.Created = dml.DateTimeFromString(created)
 		entity.Modified = dml.DateTimeFromString(modified)
 		resp.EntitySchema = &entity
+	} else if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		err = nil
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
@@ -0,0 +1,859 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-kit/kit/log/level"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+// maximum number of subareas a single template may create
+const maxTemplateSubareas = 10000
+
+// placeholder in a template name pattern for the instance number
+const templateCounter = "{n}"
+
+// error raised when a generated subarea name collides with an existing one, or one generated by the same template
+type templateConflictError struct {
+	parentSubareaId int64
+	parentName      string
+	name            string
+	generated       bool
+}
+
+func (e *templateConflictError) Error() string {
+	return fmt.Sprintf("duplicate subarea_name '%s'", e.name)
+}
+
+// create a tree of subareas from a template
+func (s *invService) InstantiateTemplate(ctx context.Context, req *pb.InstantiateTemplateRequest) (*pb.InstantiateTemplateResponse, error) {
+	resp := &pb.InstantiateTemplateResponse{}
+
+	nodes := req.GetSubareaTemplate().GetNodes()
+	if len(nodes) == 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "subarea_template nodes missing"
+		return resp, nil
+	}
+
+	total, msg := countTemplateSubareas(nodes, "nodes")
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	if total > maxTemplateSubareas {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = fmt.Sprintf("subarea_template creates %d subareas, limit is %d", total, maxTemplateSubareas)
+		return resp, nil
+	}
+
+	gResp, _ := s.GetFacilityHelper(req.GetMserviceId(), req.GetFacilityId(), false)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = "facility " + gResp.ErrorMessage
+		return resp, nil
+	}
+
+	tx, err := s.pool.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Begin failed"
+		return resp, nil
+	}
+
+	defer tx.Rollback()
+
+	// the parent subarea is locked by the reference check, so its type and the rules are read after it
+	what := "checkSubareaReferences"
+	msg, err = s.checkSubareaReferences(tx, req.GetMserviceId(), 0, req.GetFacilityId(), req.GetParentSubareaId(), 0)
+	if (err == nil) && (msg == "") {
+		var parentTypeId int32
+		var rules map[int32]map[int32]bool
+
+		what = "getSubareaTypeId"
+		parentTypeId, err = getSubareaTypeId(tx, req.GetMserviceId(), req.GetParentSubareaId())
+		if err == nil {
+			what = "loadNestingRules"
+			rules, err = loadNestingRules(tx, req.GetMserviceId())
+		}
+
+		if err == nil {
+			msg = s.checkTemplateNesting(req.GetMserviceId(), rules, parentTypeId, nodes, "nodes")
+			resp.ErrorReason = reasonFor(msg, reasonTypeRule)
+		}
+	}
+
+	if (err == nil) && (msg == "") {
+		what = "checkReferences"
+		msg, err = s.checkReferences(tx, req.GetMserviceId(), templateTypeReferences(nodes, "nodes", make(map[int32]bool)))
+	}
+
+	if (err == nil) && (msg == "") {
+		var schema *jsonschema.Schema
+		what = "loadJsonSchema"
+		schema, err = s.loadJsonSchema(tx, req.GetMserviceId(), "subarea")
+		if err == nil {
+			msg = checkTemplateJsonData(schema, nodes, "nodes")
+		}
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", what, "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	position, err := nextSubareaPosition(tx, req.GetMserviceId(), req.GetFacilityId(), req.GetParentSubareaId(), 0)
+	if err != nil {
+		level.Error(s.logger).Log("what", "nextSubareaPosition", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_Subarea (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, 
+		inbFacilityId, inbParentSubareaId, intPosition, intSubareaTypeId, chvSubareaName, chvJsonData) 
+		VALUES(NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?)`
+
+	stmt, err := tx.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	subareaIds := make([]int64, 0, total)
+
+	err = instantiateTemplateNodes(stmt, req.GetMserviceId(), req.GetFacilityId(), req.GetParentSubareaId(), "",
+		position, nodes, &subareaIds)
+
+	conflict, isConflict := err.(*templateConflictError)
+	if isConflict && (conflict.parentSubareaId == req.GetParentSubareaId()) {
+		// a subarea under a generated parent can only clash with another generated one
+		conflict.generated, err = isTemplateSubarea(tx, req.GetMserviceId(), req.GetFacilityId(), conflict, subareaIds)
+		if err != nil {
+			level.Error(s.logger).Log("what", "isTemplateSubarea", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+	}
+
+	if isConflict {
+		resp.ErrorCode = 501
+		if conflict.parentSubareaId != req.GetParentSubareaId() {
+			resp.ErrorMessage = fmt.Sprintf("subarea_template generates subarea_name '%s' more than once under '%s'",
+				conflict.name, conflict.parentName)
+		} else if conflict.generated {
+			resp.ErrorMessage = fmt.Sprintf("subarea_template generates subarea_name '%s' more than once at its top level",
+				conflict.name)
+		} else {
+			resp.ErrorMessage = s.subareaConflictMessage(req.GetMserviceId(), req.GetFacilityId(),
+				req.GetParentSubareaId(), conflict.name)
+			resp.ErrorReason = reasonNameInUse
+		}
+
+		return resp, nil
+	}
+
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		return resp, nil
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Commit", "error", err)
+		return resp, nil
+	}
+
+	resp.SubareaIds = subareaIds
+
+	return resp, nil
+}
+
+// Helper to tell whether the subarea a generated name collided with was created earlier by the same template, rather
+// than one that already existed. The unique key also holds deleted subareas, so they are looked up too.
+func isTemplateSubarea(q dbQueryer, mserviceId int64, facilityId int64, conflict *templateConflictError,
+	subareaIds []int64) (bool, error) {
+
+	sqlstring := `SELECT inbSubareaId FROM tb_Subarea
+	WHERE inbMserviceId = ? AND inbFacilityId = ? AND inbParentSubareaId = ? AND chvSubareaName = ?`
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return false, err
+	}
+
+	defer stmt.Close()
+
+	var subareaId int64
+	err = stmt.QueryRow(mserviceId, facilityId, conflict.parentSubareaId, conflict.name).Scan(&subareaId)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, id := range subareaIds {
+		if id == subareaId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Helper to validate template nodes and count the subareas they expand to.
+func countTemplateSubareas(nodes []*pb.SubareaTemplateNode, path string) (int, string) {
+	total := 0
+
+	for i, node := range nodes {
+		nodePath := path + "[" + strconv.Itoa(i) + "]"
+
+		if strings.TrimSpace(node.GetNamePattern()) == "" {
+			return 0, nodePath + ".name_pattern missing"
+		}
+
+		if node.GetSubareaTypeId() == 0 {
+			return 0, nodePath + ".subarea_type_id missing"
+		}
+
+		count := templateNodeCount(node)
+		if count < 1 {
+			return 0, nodePath + ".count must be positive"
+		}
+
+		if (count > 1) && !strings.Contains(node.GetNamePattern(), templateCounter) {
+			return 0, nodePath + ".name_pattern needs " + templateCounter + " when count is more than one"
+		}
+
+		children, msg := countTemplateSubareas(node.GetChildren(), nodePath+".children")
+		if msg != "" {
+			return 0, msg
+		}
+
+		total += count * (1 + children)
+		if total > maxTemplateSubareas {
+			// no need to keep counting
+			return total, ""
+		}
+	}
+
+	return total, ""
+}
+
+// Helper to get a reference to each distinct subarea type of the template nodes, named by the first node using it.
+func templateTypeReferences(nodes []*pb.SubareaTemplateNode, path string, seen map[int32]bool) []reference {
+	refs := make([]reference, 0)
+
+	for i, node := range nodes {
+		nodePath := path + "[" + strconv.Itoa(i) + "]"
+
+		if !seen[node.GetSubareaTypeId()] {
+			seen[node.GetSubareaTypeId()] = true
+			refs = append(refs, reference{field: nodePath + ".subarea_type_id", table: "tb_SubareaType",
+				column: "intSubareaTypeId", id: int64(node.GetSubareaTypeId()), required: true})
+		}
+
+		refs = append(refs, templateTypeReferences(node.GetChildren(), nodePath+".children", seen)...)
+	}
+
+	return refs
+}
+
+// Helper to check the json_data of each template node against the account's subarea schema, returning a message
+// naming the first node that does not match.
+func checkTemplateJsonData(schema *jsonschema.Schema, nodes []*pb.SubareaTemplateNode, path string) string {
+	for i, node := range nodes {
+		nodePath := path + "[" + strconv.Itoa(i) + "]"
+
+		if msg := checkLoadedJsonData(schema, "subarea", node.GetJsonData()); msg != "" {
+			return nodePath + "." + msg
+		}
+
+		if msg := checkTemplateJsonData(schema, node.GetChildren(), nodePath+".children"); msg != "" {
+			return msg
+		}
+	}
+
+	return ""
+}
+
+// Helper to get the instance count of a template node, defaulting to one.
+func templateNodeCount(node *pb.SubareaTemplateNode) int {
+	if node.GetCount() == 0 {
+		return 1
+	}
+
+	return int(node.GetCount())
+}
+
+// Helper to insert the subareas for a list of sibling template nodes, then recurse into their children.
+func instantiateTemplateNodes(stmt *sql.Stmt, mserviceId int64, facilityId int64, parentSubareaId int64,
+	parentName string, position int32, nodes []*pb.SubareaTemplateNode, subareaIds *[]int64) error {
+
+	for _, node := range nodes {
+		for n := 1; n <= templateNodeCount(node); n++ {
+			name := strings.TrimSpace(strings.ReplaceAll(node.GetNamePattern(), templateCounter, strconv.Itoa(n)))
+
+			res, err := stmt.Exec(mserviceId, facilityId, parentSubareaId, position, node.GetSubareaTypeId(),
+				name, node.GetJsonData())
+			if err != nil {
+				if isDuplicateKey(err) {
+					return &templateConflictError{parentSubareaId: parentSubareaId, parentName: parentName, name: name}
+				}
+				return err
+			}
+
+			subareaId, err := res.LastInsertId()
+			if err != nil {
+				return err
+			}
+
+			*subareaIds = append(*subareaIds, subareaId)
+			position++
+
+			err = instantiateTemplateNodes(stmt, mserviceId, facilityId, subareaId, name, 1, node.GetChildren(), subareaIds)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// rewrite the positions of all children of a parent subarea
+func (s *invService) ReorderSubareas(ctx context.Context, req *pb.ReorderSubareasRequest) (*pb.ReorderSubareasResponse, error) {
+	resp := &pb.ReorderSubareasResponse{}
+
+	subareaIds := req.GetSubareaIds()
+	if len(subareaIds) == 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "subarea_ids missing"
+		return resp, nil
+	}
+
+	listed := make(map[int64]bool)
+	for _, subareaId := range subareaIds {
+		if listed[subareaId] {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = fmt.Sprintf("subarea_ids lists subarea %d more than once", subareaId)
+			return resp, nil
+		}
+
+		listed[subareaId] = true
+	}
+
+	tx, err := s.pool.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Begin failed"
+		return resp, nil
+	}
+
+	defer tx.Rollback()
+
+	sqlstring := `SELECT inbSubareaId, intVersion, intPosition FROM tb_Subarea 
+	WHERE inbMserviceId = ? AND inbFacilityId = ? AND inbParentSubareaId = ? AND bitIsDeleted = 0
+	ORDER BY intPosition FOR UPDATE`
+
+	stmt, err := tx.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetMserviceId(), req.GetFacilityId(), req.GetParentSubareaId())
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	versions := make(map[int64]int32)
+	positions := make(map[int64]int32)
+	for rows.Next() {
+		var subareaId int64
+		var version int32
+		var position int32
+
+		err = rows.Scan(&subareaId, &version, &position)
+		if err != nil {
+			rows.Close()
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		versions[subareaId] = version
+		positions[subareaId] = position
+	}
+
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		level.Error(s.logger).Log("what", "Next", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	parent := fmt.Sprintf("parent subarea %d in facility %d", req.GetParentSubareaId(), req.GetFacilityId())
+	if req.GetParentSubareaId() == 0 {
+		parent = fmt.Sprintf("top level of facility %d", req.GetFacilityId())
+	}
+
+	for _, subareaId := range subareaIds {
+		if _, ok := versions[subareaId]; !ok {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = fmt.Sprintf("subarea %d is not a child of the %s", subareaId, parent)
+			resp.ErrorReason = reasonNotAChild
+			return resp, nil
+		}
+	}
+
+	if len(versions) != len(subareaIds) {
+		for subareaId := range versions {
+			if !listed[subareaId] {
+				resp.ErrorCode = 510
+				resp.ErrorMessage = fmt.Sprintf("subarea_ids must list every child of the %s, subarea %d is missing",
+					parent, subareaId)
+				resp.ErrorReason = reasonChildrenChanged
+				return resp, nil
+			}
+		}
+	}
+
+	sqlstring = `UPDATE tb_Subarea SET dtmModified = NOW(), intVersion = intVersion + 1, intPosition = ? 
+	WHERE inbSubareaId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	updateStmt, err := tx.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer updateStmt.Close()
+
+	for i, subareaId := range subareaIds {
+		position := int32(i + 1)
+		if positions[subareaId] == position {
+			// unchanged subareas keep their version
+			continue
+		}
+
+		_, err = updateStmt.Exec(position, subareaId, req.GetMserviceId())
+		if err != nil {
+			level.Error(s.logger).Log("what", "Exec", "error", err)
+			resp.ErrorCode = 501
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		versions[subareaId]++
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Commit", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	resp.Versions = versions
+
+	return resp, nil
+}
+
+// copy a facility and its subarea hierarchy to a new facility
+func (s *invService) CloneFacility(ctx context.Context, req *pb.CloneFacilityRequest) (*pb.CloneFacilityResponse, error) {
+	resp := &pb.CloneFacilityResponse{}
+
+	name := strings.TrimSpace(req.GetFacilityName())
+	if name == "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "facility_name missing"
+		return resp, nil
+	}
+
+	gResp, facility := s.GetFacilityHelper(req.GetMserviceId(), req.GetFacilityId(), false)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	tx, err := s.pool.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Begin failed"
+		return resp, nil
+	}
+
+	defer tx.Rollback()
+
+	gResp, subareas := s.GetSubareasHelper(tx, req.GetMserviceId(), req.GetFacilityId(), nil, false)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// the copies are new records, so their json_data must match the account's current schemas
+	schemas := make(map[string]*jsonschema.Schema)
+	var msg string
+	for _, entityName := range []string{"facility", "subarea", "inventoryitem"} {
+		schemas[entityName], err = s.loadJsonSchema(tx, req.GetMserviceId(), entityName)
+		if err != nil {
+			break
+		}
+	}
+
+	if err == nil {
+		msg = checkLoadedJsonData(schemas["facility"], "facility", facility.GetJsonData())
+		if msg != "" {
+			msg = fmt.Sprintf("facility %d %s", req.GetFacilityId(), msg)
+		}
+	}
+
+	for _, subarea := range subareas {
+		if (err != nil) || (msg != "") {
+			break
+		}
+
+		msg = checkLoadedJsonData(schemas["subarea"], "subarea", subarea.GetJsonData())
+		if msg != "" {
+			msg = fmt.Sprintf("subarea %d %s", subarea.GetSubareaId(), msg)
+		}
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "loadJsonSchema", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_Facility (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, 
+ 		inbMserviceId, chvFacilityName, chvJsonData) VALUES(NOW(), NOW(), NOW(), 0, 1, ?, ?, ?)`
+
+	stmt, err := tx.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetMserviceId(), name, facility.GetJsonData())
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		if isDuplicateKey(err) {
+			resp.ErrorMessage = fmt.Sprintf("facility_name '%s' already exists", name)
+			resp.ErrorReason = reasonNameInUse
+		} else {
+			level.Error(s.logger).Log("what", "Exec", "error", err)
+		}
+		return resp, nil
+	}
+
+	facilityId, err := res.LastInsertId()
+	if err != nil {
+		level.Error(s.logger).Log("what", "LastInsertId", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	subareaIds, msg, err := s.cloneSubareas(tx, req.GetMserviceId(), facilityId, subareas)
+	if err != nil {
+		level.Error(s.logger).Log("what", "cloneSubareas", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	var itemIds map[int64]int64
+	if req.GetIncludeItems() {
+		itemIds, msg, err = s.cloneInventoryItems(tx, req.GetMserviceId(), req.GetFacilityId(), subareaIds,
+			schemas["inventoryitem"])
+		if err != nil {
+			level.Error(s.logger).Log("what", "cloneInventoryItems", "error", err)
+			resp.ErrorCode = 501
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		if msg != "" {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = msg
+			return resp, nil
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Commit", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	level.Debug(s.logger).Log("facilityId", facilityId, "subareas", len(subareaIds), "items", len(itemIds))
+
+	resp.FacilityId = facilityId
+	resp.Version = 1
+	resp.SubareaIds = subareaIds
+	resp.InventoryItemIds = itemIds
+
+	return resp, nil
+}
+
+// Helper to copy subareas into a new facility, parents before children, returning the map of old to new ids, or a
+// message naming the first subarea whose type is deleted. Subareas whose parent is no longer live are skipped with
+// their descendants, as in the facility wrapper.
+func (s *invService) cloneSubareas(tx *sql.Tx, mserviceId int64, facilityId int64, subareas []*pb.Subarea) (map[int64]int64, string, error) {
+	subareaIds := make(map[int64]int64)
+	checkedTypes := make(map[int32]bool)
+
+	children := make(map[int64][]*pb.Subarea)
+	for _, subarea := range subareas {
+		children[subarea.GetParentSubareaId()] = append(children[subarea.GetParentSubareaId()], subarea)
+	}
+
+	sqlstring := `INSERT INTO tb_Subarea (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, 
+		inbFacilityId, inbParentSubareaId, intPosition, intSubareaTypeId, chvSubareaName, chvJsonData) 
+		VALUES(NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?)`
+
+	stmt, err := tx.Prepare(sqlstring)
+	if err != nil {
+		return nil, "", err
+	}
+
+	defer stmt.Close()
+
+	// breadth first from the top level, so each parent has its new id before its children are copied
+	queue := children[0]
+	for len(queue) > 0 {
+		subarea := queue[0]
+		queue = queue[1:]
+
+		var parentId int64
+		if subarea.GetParentSubareaId() != 0 {
+			parentId = subareaIds[subarea.GetParentSubareaId()]
+		}
+
+		if !checkedTypes[subarea.GetSubareaTypeId()] {
+			checkedTypes[subarea.GetSubareaTypeId()] = true
+			msg, err := s.checkReferences(tx, mserviceId, []reference{
+				{field: fmt.Sprintf("subarea %d subarea_type_id", subarea.GetSubareaId()), table: "tb_SubareaType",
+					column: "intSubareaTypeId", id: int64(subarea.GetSubareaTypeId())},
+			})
+			if (err != nil) || (msg != "") {
+				return nil, msg, err
+			}
+		}
+
+		res, err := stmt.Exec(mserviceId, facilityId, parentId, subarea.GetPosition(), subarea.GetSubareaTypeId(),
+			subarea.GetSubareaName(), subarea.GetJsonData())
+		if err != nil {
+			return nil, "", err
+		}
+
+		subareaId, err := res.LastInsertId()
+		if err != nil {
+			return nil, "", err
+		}
+
+		subareaIds[subarea.GetSubareaId()] = subareaId
+		queue = append(queue, children[subarea.GetSubareaId()]...)
+	}
+
+	return subareaIds, "", nil
+}
+
+// Helper to copy the inventory items of a facility into the cloned subareas, returning the map of old to new ids, or
+// a message naming the first item whose json_data does not match the item schema, or whose item type or product
+// is deleted.
+func (s *invService) cloneInventoryItems(tx *sql.Tx, mserviceId int64, facilityId int64, subareaIds map[int64]int64,
+	schema *jsonschema.Schema) (map[int64]int64, string, error) {
+
+	itemIds := make(map[int64]int64)
+	checkedTypes := make(map[int32]bool)
+	checkedProducts := make(map[int64]bool)
+
+	type itemCopy struct {
+		itemId       int64
+		subareaId    int64
+		itemTypeId   int32
+		quantity     int32
+		serialNumber string
+		productId    int64
+		jsonData     string
+	}
+
+	sqlstring := `SELECT i.inbInventoryItemId, i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, 
+	i.inbProductId, i.chvJsonData
+	FROM tb_InventoryItem AS i
+	JOIN tb_Subarea AS a ON i.inbSubareaId = a.inbSubareaId
+	WHERE a.inbFacilityId = ? AND i.inbMserviceId = ? AND i.bitIsDeleted = 0
+	ORDER BY i.inbInventoryItemId`
+
+	stmt, err := tx.Prepare(sqlstring)
+	if err != nil {
+		return nil, "", err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(facilityId, mserviceId)
+	if err != nil {
+		return nil, "", err
+	}
+
+	items := make([]itemCopy, 0)
+	for rows.Next() {
+		var item itemCopy
+		err = rows.Scan(&item.itemId, &item.subareaId, &item.itemTypeId, &item.quantity, &item.serialNumber,
+			&item.productId, &item.jsonData)
+		if err != nil {
+			rows.Close()
+			return nil, "", err
+		}
+
+		items = append(items, item)
+	}
+
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	sqlstring = `INSERT INTO tb_InventoryItem (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, inbSubareaId, 
+		intItemTypeId, intQuantity, chvSerialNumber, inbProductId, chvJsonData) 
+		VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?)`
+
+	insertStmt, err := tx.Prepare(sqlstring)
+	if err != nil {
+		return nil, "", err
+	}
+
+	defer insertStmt.Close()
+
+	for _, item := range items {
+		subareaId, ok := subareaIds[item.subareaId]
+		if !ok {
+			// item is in a subarea that was not copied
+			continue
+		}
+
+		if msg := checkLoadedJsonData(schema, "inventoryitem", item.jsonData); msg != "" {
+			return nil, fmt.Sprintf("inventory item %d %s", item.itemId, msg), nil
+		}
+
+		// each item type and product is checked, and share locked, for the first item using it
+		refs := make([]reference, 0, 2)
+		if !checkedTypes[item.itemTypeId] {
+			checkedTypes[item.itemTypeId] = true
+			refs = append(refs, reference{field: fmt.Sprintf("inventory item %d item_type_id", item.itemId),
+				table: "tb_ItemType", column: "intItemTypeId", id: int64(item.itemTypeId)})
+		}
+
+		if !checkedProducts[item.productId] {
+			checkedProducts[item.productId] = true
+			refs = append(refs, reference{field: fmt.Sprintf("inventory item %d product_id", item.itemId),
+				table: "tb_Product", column: "inbProductId", id: item.productId})
+		}
+
+		if msg, err := s.checkReferences(tx, mserviceId, refs); (err != nil) || (msg != "") {
+			return nil, msg, err
+		}
+
+		res, err := insertStmt.Exec(mserviceId, subareaId, item.itemTypeId, item.quantity, item.serialNumber,
+			item.productId, item.jsonData)
+		if err != nil {
+			return nil, "", err
+		}
+
+		itemId, err := res.LastInsertId()
+		if err != nil {
+			return nil, "", err
+		}
+
+		itemIds[item.itemId] = itemId
+
+		err = recordItemEvent(tx, mserviceId, itemId, itemEventCreate, 0, 0)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return itemIds, "", nil
+}
@@ -0,0 +1,600 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+// Rules are opt-in: a subarea type without any nesting rules accepts any child subarea type,
+// and a subarea type without any item type rules accepts any item type.
+
+// allow a subarea type to be nested in a parent subarea type
+func (s *invService) AddSubareaTypeNesting(ctx context.Context, req *pb.AddSubareaTypeNestingRequest) (*pb.AddSubareaTypeNestingResponse, error) {
+	resp := &pb.AddSubareaTypeNestingResponse{}
+
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.addSubareaTypeNesting(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.AddSubareaTypeNestingResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to add a nesting rule after share locking its types, so neither can be deleted before it commits.
+func (s *invService) addSubareaTypeNesting(ctx context.Context, req *pb.AddSubareaTypeNestingRequest) (*pb.AddSubareaTypeNestingResponse, error) {
+	resp := &pb.AddSubareaTypeNestingResponse{}
+
+	msg, err := s.checkSubareaTypesExist(req.GetMserviceId(), "parent_subarea_type_id", req.GetParentSubareaTypeId(),
+		"child_subarea_type_id", req.GetChildSubareaTypeId(), false)
+	if err != nil {
+		level.Error(s.logger).Log("what", "checkSubareaTypesExist", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_SubareaTypeNesting (inbMserviceId, intParentSubareaTypeId, intChildSubareaTypeId, dtmCreated)
+	VALUES(?, ?, ?, NOW())`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(req.GetMserviceId(), req.GetParentSubareaTypeId(), req.GetChildSubareaTypeId())
+	if err == nil {
+		resp.ErrorCode = 0
+	} else if isDuplicateKey(err) {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = fmt.Sprintf("subarea type %d is already allowed in subarea type %d",
+			req.GetChildSubareaTypeId(), req.GetParentSubareaTypeId())
+		resp.ErrorReason = reasonRuleExists
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+	}
+
+	return resp, nil
+}
+
+// remove a subarea type nesting rule
+func (s *invService) RemoveSubareaTypeNesting(ctx context.Context, req *pb.RemoveSubareaTypeNestingRequest) (*pb.RemoveSubareaTypeNestingResponse, error) {
+	resp := &pb.RemoveSubareaTypeNestingResponse{}
+
+	sqlstring := `DELETE FROM tb_SubareaTypeNesting WHERE inbMserviceId = ? AND intParentSubareaTypeId = ?
+	AND intChildSubareaTypeId = ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetMserviceId(), req.GetParentSubareaTypeId(), req.GetChildSubareaTypeId())
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected != 1 {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+	}
+
+	return resp, nil
+}
+
+// get all subarea type nesting rules by mservice_id
+func (s *invService) GetSubareaTypeNestings(ctx context.Context, req *pb.GetSubareaTypeNestingsRequest) (*pb.GetSubareaTypeNestingsResponse, error) {
+	resp := &pb.GetSubareaTypeNestingsResponse{}
+
+	sqlstring := `SELECT n.inbMserviceId, n.intParentSubareaTypeId, n.intChildSubareaTypeId, n.dtmCreated,
+	p.chvSubareaTypeName, c.chvSubareaTypeName
+	FROM tb_SubareaTypeNesting AS n
+	LEFT JOIN tb_SubareaType AS p ON n.inbMserviceId = p.inbMserviceId AND n.intParentSubareaTypeId = p.intSubareaTypeId
+	LEFT JOIN tb_SubareaType AS c ON n.inbMserviceId = c.inbMserviceId AND n.intChildSubareaTypeId = c.intSubareaTypeId
+	WHERE n.inbMserviceId = ?
+	ORDER BY n.intParentSubareaTypeId, n.intChildSubareaTypeId`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var created string
+		var nesting pb.SubareaTypeNesting
+		var parentName sql.NullString
+		var childName sql.NullString
+
+		err := rows.Scan(&nesting.MserviceId, &nesting.ParentSubareaTypeId, &nesting.ChildSubareaTypeId, &created,
+			&parentName, &childName)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		nesting.Created = dml.DateTimeFromString(created)
+		if parentName.Valid {
+			nesting.ParentSubareaTypeName = parentName.String
+		}
+		if childName.Valid {
+			nesting.ChildSubareaTypeName = childName.String
+		}
+
+		resp.Nestings = append(resp.Nestings, &nesting)
+	}
+
+	return resp, nil
+}
+
+// allow an item type to be stored in a subarea type
+func (s *invService) AddSubareaItemType(ctx context.Context, req *pb.AddSubareaItemTypeRequest) (*pb.AddSubareaItemTypeResponse, error) {
+	resp := &pb.AddSubareaItemTypeResponse{}
+
+	err := s.inTransaction(func(txs *invService) bool {
+		resp, _ = txs.addSubareaItemType(ctx, req)
+		return resp.GetErrorCode() == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.AddSubareaItemTypeResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to add a item type rule after share locking its types, so neither can be deleted before it commits.
+func (s *invService) addSubareaItemType(ctx context.Context, req *pb.AddSubareaItemTypeRequest) (*pb.AddSubareaItemTypeResponse, error) {
+	resp := &pb.AddSubareaItemTypeResponse{}
+
+	msg, err := s.checkSubareaTypesExist(req.GetMserviceId(), "subarea_type_id", req.GetSubareaTypeId(),
+		"item_type_id", req.GetItemTypeId(), true)
+	if err != nil {
+		level.Error(s.logger).Log("what", "checkSubareaTypesExist", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_SubareaTypeItemType (inbMserviceId, intSubareaTypeId, intItemTypeId, dtmCreated)
+	VALUES(?, ?, ?, NOW())`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(req.GetMserviceId(), req.GetSubareaTypeId(), req.GetItemTypeId())
+	if err == nil {
+		resp.ErrorCode = 0
+	} else if isDuplicateKey(err) {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = fmt.Sprintf("item type %d is already allowed in subarea type %d",
+			req.GetItemTypeId(), req.GetSubareaTypeId())
+		resp.ErrorReason = reasonRuleExists
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+	}
+
+	return resp, nil
+}
+
+// remove a subarea item type rule
+func (s *invService) RemoveSubareaItemType(ctx context.Context, req *pb.RemoveSubareaItemTypeRequest) (*pb.RemoveSubareaItemTypeResponse, error) {
+	resp := &pb.RemoveSubareaItemTypeResponse{}
+
+	sqlstring := `DELETE FROM tb_SubareaTypeItemType WHERE inbMserviceId = ? AND intSubareaTypeId = ?
+	AND intItemTypeId = ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetMserviceId(), req.GetSubareaTypeId(), req.GetItemTypeId())
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected != 1 {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+	}
+
+	return resp, nil
+}
+
+// get all subarea item type rules by mservice_id
+func (s *invService) GetSubareaItemTypes(ctx context.Context, req *pb.GetSubareaItemTypesRequest) (*pb.GetSubareaItemTypesResponse, error) {
+	resp := &pb.GetSubareaItemTypesResponse{}
+
+	sqlstring := `SELECT r.inbMserviceId, r.intSubareaTypeId, r.intItemTypeId, r.dtmCreated,
+	t.chvSubareaTypeName, i.chvItemTypeName
+	FROM tb_SubareaTypeItemType AS r
+	LEFT JOIN tb_SubareaType AS t ON r.inbMserviceId = t.inbMserviceId AND r.intSubareaTypeId = t.intSubareaTypeId
+	LEFT JOIN tb_ItemType AS i ON r.inbMserviceId = i.inbMserviceId AND r.intItemTypeId = i.intItemTypeId
+	WHERE r.inbMserviceId = ?
+	ORDER BY r.intSubareaTypeId, r.intItemTypeId`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var created string
+		var rule pb.SubareaItemType
+		var subtypeName sql.NullString
+		var itemtypeName sql.NullString
+
+		err := rows.Scan(&rule.MserviceId, &rule.SubareaTypeId, &rule.ItemTypeId, &created,
+			&subtypeName, &itemtypeName)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		rule.Created = dml.DateTimeFromString(created)
+		if subtypeName.Valid {
+			rule.SubareaTypeName = subtypeName.String
+		}
+		if itemtypeName.Valid {
+			rule.ItemTypeName = itemtypeName.String
+		}
+
+		resp.SubareaItemTypes = append(resp.SubareaItemTypes, &rule)
+	}
+
+	return resp, nil
+}
+
+// Helper to check that the subarea type, and the second subarea or item type, of a rule are live, share locking them.
+func (s *invService) checkSubareaTypesExist(mserviceId int64, firstField string, subareaTypeId int32,
+	secondField string, secondTypeId int32, secondIsItemType bool) (string, error) {
+
+	second := reference{field: secondField, table: "tb_SubareaType", column: "intSubareaTypeId", id: int64(secondTypeId),
+		required: true}
+	if secondIsItemType {
+		second.table = "tb_ItemType"
+		second.column = "intItemTypeId"
+	}
+
+	return s.checkReferences(s.db, mserviceId, []reference{
+		{field: firstField, table: "tb_SubareaType", column: "intSubareaTypeId", id: int64(subareaTypeId), required: true},
+		second,
+	})
+}
+
+// Helper to get the name of a live subarea type, or of a live item type.
+func (s *invService) typeName(q dbQueryer, mserviceId int64, typeId int32, isItemType bool) (string, error) {
+	sqlstring := `SELECT chvSubareaTypeName FROM tb_SubareaType
+	WHERE inbMserviceId = ? AND intSubareaTypeId = ? AND bitIsDeleted = 0`
+	if isItemType {
+		sqlstring = `SELECT chvItemTypeName FROM tb_ItemType
+		WHERE inbMserviceId = ? AND intItemTypeId = ? AND bitIsDeleted = 0`
+	}
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		return "", err
+	}
+
+	defer stmt.Close()
+
+	var name string
+	err = stmt.QueryRow(mserviceId, typeId).Scan(&name)
+	if err != nil && err != sql.ErrNoRows {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+	}
+
+	return name, err
+}
+
+// Helper to describe a subarea type or item type by id and, when known, name.
+func (s *invService) describeType(q dbQueryer, mserviceId int64, typeId int32, isItemType bool) string {
+	kind := "subarea type "
+	if isItemType {
+		kind = "item type "
+	}
+
+	desc := kind + strconv.Itoa(int(typeId))
+	if name, err := s.typeName(q, mserviceId, typeId, isItemType); err == nil {
+		desc += " (" + name + ")"
+	}
+
+	return desc
+}
+
+// Helper to check a nesting or item type rule, returning a descriptive message if the rule is violated.
+func (s *invService) checkTypeRule(q dbQueryer, mserviceId int64, subareaTypeId int32, typeId int32, isItemType bool) (string, error) {
+	sqlstring := `SELECT COUNT(*), COALESCE(SUM(intChildSubareaTypeId = ?), 0) FROM tb_SubareaTypeNesting
+	WHERE inbMserviceId = ? AND intParentSubareaTypeId = ?`
+	if isItemType {
+		sqlstring = `SELECT COUNT(*), COALESCE(SUM(intItemTypeId = ?), 0) FROM tb_SubareaTypeItemType
+		WHERE inbMserviceId = ? AND intSubareaTypeId = ?`
+	}
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return "", err
+	}
+
+	defer stmt.Close()
+
+	var rules int
+	var matches int
+	err = stmt.QueryRow(typeId, mserviceId, subareaTypeId).Scan(&rules, &matches)
+	if err != nil {
+		return "", err
+	}
+
+	if (rules == 0) || (matches > 0) {
+		return "", nil
+	}
+
+	return fmt.Sprintf("%s is not allowed in %s", s.describeType(q, mserviceId, typeId, isItemType),
+		s.describeType(q, mserviceId, subareaTypeId, false)), nil
+}
+
+// Helper to get the type of a live subarea, zero if the subarea is not found.
+func getSubareaTypeId(q dbQueryer, mserviceId int64, subareaId int64) (int32, error) {
+	sqlstring := `SELECT intSubareaTypeId FROM tb_Subarea WHERE inbSubareaId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	var subareaTypeId int32
+	err = stmt.QueryRow(subareaId, mserviceId).Scan(&subareaTypeId)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+
+	return subareaTypeId, err
+}
+
+// Helper to check that a subarea type may be placed in a parent subarea, returning a message if it may not.
+func (s *invService) checkSubareaPlacement(q dbQueryer, mserviceId int64, parentSubareaId int64, subareaTypeId int32) (string, error) {
+	if parentSubareaId == 0 {
+		return "", nil
+	}
+
+	parentTypeId, err := getSubareaTypeId(q, mserviceId, parentSubareaId)
+	if (err != nil) || (parentTypeId == 0) {
+		return "", err
+	}
+
+	return s.checkTypeRule(q, mserviceId, parentTypeId, subareaTypeId, false)
+}
+
+// Helper to check that an item type may be stored in a subarea, returning a message if it may not.
+func (s *invService) checkItemPlacement(q dbQueryer, mserviceId int64, subareaId int64, itemTypeId int32) (string, error) {
+	subareaTypeId, err := getSubareaTypeId(q, mserviceId, subareaId)
+	if (err != nil) || (subareaTypeId == 0) {
+		return "", err
+	}
+
+	return s.checkTypeRule(q, mserviceId, subareaTypeId, itemTypeId, true)
+}
+
+// Helper to check that the existing child subareas and items of a subarea are allowed by a new subarea type.
+func (s *invService) checkSubareaContents(q dbQueryer, mserviceId int64, subareaId int64, subareaTypeId int32) (string, error) {
+	sqlstring := `SELECT DISTINCT intSubareaTypeId, 0 FROM tb_Subarea
+	WHERE inbMserviceId = ? AND inbParentSubareaId = ? AND bitIsDeleted = 0
+	UNION SELECT DISTINCT intItemTypeId, 1 FROM tb_InventoryItem
+	WHERE inbMserviceId = ? AND inbSubareaId = ? AND bitIsDeleted = 0`
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return "", err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(mserviceId, subareaId, mserviceId, subareaId)
+	if err != nil {
+		return "", err
+	}
+
+	type content struct {
+		typeId     int32
+		isItemType bool
+	}
+
+	contents := make([]content, 0)
+	for rows.Next() {
+		var c content
+		err = rows.Scan(&c.typeId, &c.isItemType)
+		if err != nil {
+			rows.Close()
+			return "", err
+		}
+
+		contents = append(contents, c)
+	}
+
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return "", err
+	}
+
+	for _, c := range contents {
+		msg, err := s.checkTypeRule(q, mserviceId, subareaTypeId, c.typeId, c.isItemType)
+		if (err != nil) || (msg != "") {
+			if msg != "" {
+				msg = fmt.Sprintf("subarea %d contains %s", subareaId, msg)
+			}
+			return msg, err
+		}
+	}
+
+	return "", nil
+}
+
+// Helper to load all nesting rules for an account, keyed by parent subarea type.
+func loadNestingRules(q dbQueryer, mserviceId int64) (map[int32]map[int32]bool, error) {
+	rules := make(map[int32]map[int32]bool)
+
+	sqlstring := `SELECT intParentSubareaTypeId, intChildSubareaTypeId FROM tb_SubareaTypeNesting WHERE inbMserviceId = ?`
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(mserviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var parentTypeId int32
+		var childTypeId int32
+		err = rows.Scan(&parentTypeId, &childTypeId)
+		if err != nil {
+			return nil, err
+		}
+
+		if rules[parentTypeId] == nil {
+			rules[parentTypeId] = make(map[int32]bool)
+		}
+
+		rules[parentTypeId][childTypeId] = true
+	}
+
+	return rules, rows.Err()
+}
+
+// Helper to check template nodes against the nesting rules, returning a message for the first violation.
+func (s *invService) checkTemplateNesting(mserviceId int64, rules map[int32]map[int32]bool, parentTypeId int32,
+	nodes []*pb.SubareaTemplateNode, path string) string {
+
+	for i, node := range nodes {
+		nodePath := path + "[" + strconv.Itoa(i) + "]"
+
+		allowed, ok := rules[parentTypeId]
+		if (parentTypeId != 0) && ok && !allowed[node.GetSubareaTypeId()] {
+			return fmt.Sprintf("%s: %s is not allowed in %s", nodePath,
+				s.describeType(s.db, mserviceId, node.GetSubareaTypeId(), false),
+				s.describeType(s.db, mserviceId, parentTypeId, false))
+		}
+
+		msg := s.checkTemplateNesting(mserviceId, rules, node.GetSubareaTypeId(), node.GetChildren(), nodePath+".children")
+		if msg != "" {
+			return msg
+		}
+	}
+
+	return ""
+}
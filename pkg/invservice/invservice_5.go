@@ -0,0 +1,169 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+// default number of search results in a page
+const defaultSearchPageSize = 20
+
+// maximum number of search results in a page
+const maxSearchPageSize = 100
+
+// maximum number of words in a search query
+const maxSearchWords = 20
+
+// position in search results, carried between requests as an opaque page token
+type searchToken struct {
+	Offset int32  `json:"o"`
+	Query  uint32 `json:"q"`
+}
+
+// full text search of product name, sku and comment
+func (s *invService) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	resp := &pb.SearchProductsResponse{}
+
+	query := booleanQuery(req.GetQuery())
+	if query == "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "query missing"
+		return resp, nil
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize < 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "page_size must not be negative"
+		return resp, nil
+	}
+
+	if pageSize == 0 {
+		pageSize = defaultSearchPageSize
+	} else if pageSize > maxSearchPageSize {
+		pageSize = maxSearchPageSize
+	}
+
+	var offset int32
+	if req.GetPageToken() != "" {
+		var token searchToken
+		buf, err := base64.RawURLEncoding.DecodeString(req.GetPageToken())
+		if err == nil {
+			err = json.Unmarshal(buf, &token)
+		}
+
+		if (err != nil) || (token.Offset <= 0) {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "page_token invalid"
+			return resp, nil
+		}
+
+		if token.Query != queryHash(query) {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "page_token does not match query"
+			return resp, nil
+		}
+
+		offset = token.Offset
+	}
+
+	sqlstring := `SELECT inbProductId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvSku, chvProductName,
+	chvComment, chvJsonData, MATCH (chvProductName, chvSku, chvComment) AGAINST (? IN BOOLEAN MODE) AS relevance
+	FROM tb_Product WHERE inbMserviceId = ? AND bitIsDeleted = 0
+	AND MATCH (chvProductName, chvSku, chvComment) AGAINST (? IN BOOLEAN MODE)
+	ORDER BY relevance DESC, inbProductId LIMIT ? OFFSET ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	// one more than the page size, to tell whether another page follows
+	rows, err := stmt.Query(query, req.GetMserviceId(), query, pageSize+1, offset)
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var created string
+		var modified string
+		var product pb.Product
+		var result pb.ProductSearchResult
+
+		err := rows.Scan(&product.ProductId, &created, &modified, &product.Version,
+			&product.MserviceId, &product.Sku, &product.ProductName, &product.Comment, &product.JsonData,
+			&result.Relevance)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		product.Created = dml.DateTimeFromString(created)
+		product.Modified = dml.DateTimeFromString(modified)
+		result.Product = &product
+		resp.Results = append(resp.Results, &result)
+	}
+
+	if len(resp.Results) > int(pageSize) {
+		resp.Results = resp.Results[:pageSize]
+		buf, _ := json.Marshal(searchToken{Offset: offset + pageSize, Query: queryHash(query)})
+		resp.NextPageToken = base64.RawURLEncoding.EncodeToString(buf)
+	}
+
+	return resp, nil
+}
+
+// Helper to convert search words into a MySQL boolean mode query where every word must match as a prefix.
+// Only letters and digits are kept, so user input cannot inject boolean operators.
+func booleanQuery(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	if len(words) > maxSearchWords {
+		words = words[:maxSearchWords]
+	}
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = append(terms, "+"+word+"*")
+	}
+
+	return strings.Join(terms, " ")
+}
@@ -0,0 +1,510 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	"github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+	"google.golang.org/protobuf/proto"
+)
+
+// An indexed json path gets a VIRTUAL generated column holding its value and an index on
+// (inbMserviceId, column). Columns are shared by every account indexing the same path and value type.
+// Adding or dropping one alters a table every account uses, so by default only the operator does it, with
+// invserver json_index_column, and create_json_index can only register a path whose column already exists.
+// With json_index_ddl set, create_json_index adds missing columns and delete_json_index drops the column
+// when the last account deletes its index.
+
+// value types of an indexed json path
+const (
+	jsonIndexString = "string"
+	jsonIndexNumber = "number"
+)
+
+// maximum length of an indexed json path
+const maxJsonPathLength = 200
+
+// number of characters of a string value held in its generated column
+const jsonIndexStringLength = 255
+
+// name of the lock serializing json index column changes
+const jsonIndexLock = "inventory_json_index"
+
+// seconds to wait for the json index lock
+const jsonIndexLockTimeout = 30
+
+// maximum number of json index columns in a table, well under the 64 indexes MySQL allows
+const maxJsonIndexColumns = 16
+
+// tables of the entities whose json_data can be indexed
+var jsonIndexTables = map[string]string{
+	"product":       "tb_Product",
+	"inventoryitem": "tb_InventoryItem",
+}
+
+// index a json_data extension field of an entity for filtering
+func (s *invService) CreateJsonIndex(ctx context.Context, req *pb.CreateJsonIndexRequest) (*pb.CreateJsonIndexResponse, error) {
+	if req.GetRequestId() != "" {
+		resp := &pb.CreateJsonIndexResponse{}
+		s.createOnce(req, resp, func(txs *invService, once proto.Message) createResponse {
+			created, _ := txs.CreateJsonIndex(ctx, once.(*pb.CreateJsonIndexRequest))
+			return created
+		})
+
+		return resp, nil
+	}
+
+	resp := &pb.CreateJsonIndexResponse{}
+
+	entityName, table, column, msg := checkJsonIndex(req.GetEntityName(), req.GetJsonPath(), req.GetValueType())
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	valueType := strings.ToLower(req.GetValueType())
+
+	conn, err := s.lockJsonIndexes(ctx)
+	if err != nil {
+		level.Error(s.logger).Log("what", "lockJsonIndexes", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer s.unlockJsonIndexes(conn)
+
+	// the column exists before the registry points filters at it
+	exists, err := jsonIndexColumnExists(ctx, conn, table, column)
+	if (err == nil) && !exists {
+		if !s.jsonIndexDdl {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = fmt.Sprintf("json path '%s' of %s has no %s index column, ask the operator to add it",
+				req.GetJsonPath(), entityName, valueType)
+			return resp, nil
+		}
+
+		msg, err = addJsonIndexColumn(ctx, conn, table, column, req.GetJsonPath(), valueType)
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "addJsonIndexColumn", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_JsonIndex (inbMserviceId, chvEntityName, chvJsonPath, chvValueType, chvColumnName, dtmCreated)
+	VALUES(?, ?, ?, ?, ?, NOW())`
+
+	stmt, err := conn.PrepareContext(ctx, sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(req.GetMserviceId(), entityName, req.GetJsonPath(), valueType, column)
+	if isDuplicateKey(err) {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = fmt.Sprintf("json path '%s' of %s is already indexed as %s", req.GetJsonPath(),
+			entityName, valueType)
+		return resp, nil
+	} else if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		return resp, nil
+	}
+
+	resp.ColumnName = column
+
+	return resp, nil
+}
+
+// delete a json_data extension field index
+func (s *invService) DeleteJsonIndex(ctx context.Context, req *pb.DeleteJsonIndexRequest) (*pb.DeleteJsonIndexResponse, error) {
+	resp := &pb.DeleteJsonIndexResponse{}
+
+	entityName, table, column, msg := checkJsonIndex(req.GetEntityName(), req.GetJsonPath(), req.GetValueType())
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	valueType := strings.ToLower(req.GetValueType())
+
+	conn, err := s.lockJsonIndexes(ctx)
+	if err != nil {
+		level.Error(s.logger).Log("what", "lockJsonIndexes", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer s.unlockJsonIndexes(conn)
+
+	sqlstring := `DELETE FROM tb_JsonIndex WHERE inbMserviceId = ? AND chvEntityName = ? AND chvJsonPath = ?
+	AND chvValueType = ?`
+
+	stmt, err := conn.PrepareContext(ctx, sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetMserviceId(), entityName, req.GetJsonPath(), valueType)
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		return resp, nil
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	}
+
+	if !s.jsonIndexDdl {
+		// the operator drops unused columns
+		return resp, nil
+	}
+
+	_, err = dropJsonIndexColumn(ctx, conn, entityName, table, column)
+	if err != nil {
+		// the index is gone for this account, an unused column only costs space
+		level.Error(s.logger).Log("what", "drop json index column", "column", column, "error", err)
+	}
+
+	return resp, nil
+}
+
+// Set whether create_json_index and delete_json_index may add and drop generated columns, altering tables that
+// every account uses. Otherwise the operator manages the columns with JsonIndexColumn.
+func (s *invService) SetJsonIndexDdl(enabled bool) {
+	s.jsonIndexDdl = enabled
+}
+
+// Add the generated column and index for a json path of an entity, or drop them if no account still indexes the
+// path. Returns the column name and a description of what was done.
+func (s *invService) JsonIndexColumn(ctx context.Context, entityName string, path string, valueType string,
+	drop bool) (string, string, error) {
+
+	entityName, table, column, msg := checkJsonIndex(entityName, path, valueType)
+	if msg != "" {
+		return "", "", errors.New(msg)
+	}
+
+	conn, err := s.lockJsonIndexes(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	defer s.unlockJsonIndexes(conn)
+
+	exists, err := jsonIndexColumnExists(ctx, conn, table, column)
+	if err != nil {
+		return "", "", err
+	}
+
+	if drop {
+		if !exists {
+			return column, "does not exist", nil
+		}
+
+		dropped, err := dropJsonIndexColumn(ctx, conn, entityName, table, column)
+		if (err == nil) && !dropped {
+			err = fmt.Errorf("%s is still indexed by some accounts, they must delete their json index first", column)
+		}
+
+		if err != nil {
+			return "", "", err
+		}
+
+		return column, "dropped", nil
+	}
+
+	if exists {
+		return column, "already exists", nil
+	}
+
+	msg, err = addJsonIndexColumn(ctx, conn, table, column, path, strings.ToLower(valueType))
+	if (err == nil) && (msg != "") {
+		err = errors.New(msg)
+	}
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return column, "added", nil
+}
+
+// get all json_data extension field indexes for account
+func (s *invService) GetJsonIndexes(ctx context.Context, req *pb.GetJsonIndexesRequest) (*pb.GetJsonIndexesResponse, error) {
+	resp := &pb.GetJsonIndexesResponse{}
+
+	sqlstring := `SELECT inbMserviceId, chvEntityName, chvJsonPath, chvValueType, chvColumnName, dtmCreated
+	FROM tb_JsonIndex WHERE inbMserviceId = ? ORDER BY chvEntityName, chvJsonPath, chvValueType`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var created string
+		var index pb.JsonIndex
+
+		err := rows.Scan(&index.MserviceId, &index.EntityName, &index.JsonPath, &index.ValueType, &index.ColumnName,
+			&created)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		index.Created = dml.DateTimeFromString(created)
+
+		resp.JsonIndexes = append(resp.JsonIndexes, &index)
+	}
+
+	return resp, nil
+}
+
+// Helper to validate a json index, returning the normalized entity name, its table and the generated column name.
+func checkJsonIndex(entityName string, path string, valueType string) (string, string, string, string) {
+	entityName = strings.ToLower(entityName)
+	table, ok := jsonIndexTables[entityName]
+	if !ok {
+		return "", "", "", "json index entity_name must be product or inventoryitem"
+	}
+
+	if (len(path) > maxJsonPathLength) || !jsonPathPattern.MatchString(path) {
+		return "", "", "", fmt.Sprintf("json_path '%s' invalid, use member names and array indexes such as $.a.b[0]", path)
+	}
+
+	valueType = strings.ToLower(valueType)
+	if (valueType != jsonIndexString) && (valueType != jsonIndexNumber) {
+		return "", "", "", "value_type must be string or number"
+	}
+
+	return entityName, table, jsonIndexColumnName(path, valueType), ""
+}
+
+// Helper to get the key of a json path and value type in the map of generated columns.
+func jsonIndexKey(path string, valueType string) string {
+	return path + "\n" + valueType
+}
+
+// Helper to get the generated column name for a json path and value type, readable and unique within a table.
+func jsonIndexColumnName(path string, valueType string) string {
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+
+		return '_'
+	}, path[1:])
+
+	name = strings.Trim(name, "_")
+	if len(name) > 32 {
+		name = name[:32]
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(jsonIndexKey(path, valueType)))
+
+	return fmt.Sprintf("jx_%s_%s_%08x", name, valueType[:1], h.Sum32())
+}
+
+// Helper to tell whether the generated column for a json path exists.
+func jsonIndexColumnExists(ctx context.Context, conn *sql.Conn, table string, column string) (bool, error) {
+	var count int
+	err := conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE()
+	AND TABLE_NAME = ? AND COLUMN_NAME = ?`, table, column).Scan(&count)
+
+	return count > 0, err
+}
+
+// Helper to add the generated column and index for a json path, returning a message if the table already has as
+// many json index columns as allowed. The path has been validated, so it can be embedded in the column definition.
+func addJsonIndexColumn(ctx context.Context, conn *sql.Conn, table string, column string, path string,
+	valueType string) (string, error) {
+
+	var count int
+	err := conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE()
+	AND TABLE_NAME = ? AND COLUMN_NAME LIKE 'jx\\_%'`, table).Scan(&count)
+	if err != nil {
+		return "", err
+	}
+
+	if count >= maxJsonIndexColumns {
+		return fmt.Sprintf("%s already has %d json index columns, the most allowed", table, count), nil
+	}
+
+	var definition string
+	if valueType == jsonIndexNumber {
+		definition = "DOUBLE GENERATED ALWAYS AS (" + jsonNumber("chvJsonData") + ") VIRTUAL"
+	} else {
+		definition = fmt.Sprintf("VARCHAR(%d) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin GENERATED ALWAYS AS (LEFT(%s, %d)) VIRTUAL",
+			jsonIndexStringLength, jsonString("chvJsonData"), jsonIndexStringLength)
+	}
+
+	definition = strings.ReplaceAll(definition, "?", "'"+path+"'")
+
+	sqlstring := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s, ADD INDEX ix_%s (inbMserviceId, %s)",
+		table, column, definition, column, column)
+
+	_, err = conn.ExecContext(ctx, sqlstring)
+	if mysqlErr, ok := err.(*mysql.MySQLError); ok && (mysqlErr.Number == 1060) {
+		// duplicate column, added since it was checked
+		return "", nil
+	}
+
+	return "", err
+}
+
+// Helper to drop the generated column for a json path unless an account still indexes it, reporting whether it
+// was dropped.
+func dropJsonIndexColumn(ctx context.Context, conn *sql.Conn, entityName string, table string, column string) (bool, error) {
+	var users int
+	err := conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM tb_JsonIndex WHERE chvEntityName = ? AND chvColumnName = ?`,
+		entityName, column).Scan(&users)
+	if (err != nil) || (users > 0) {
+		return false, err
+	}
+
+	_, err = conn.ExecContext(ctx, "ALTER TABLE "+table+" DROP COLUMN "+column)
+
+	return err == nil, err
+}
+
+// Helper to take the lock serializing json index column changes, on a connection the caller must unlock.
+func (s *invService) lockJsonIndexes(ctx context.Context) (*sql.Conn, error) {
+	conn, err := s.pool.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var locked sql.NullInt64
+	err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", jsonIndexLock, jsonIndexLockTimeout).Scan(&locked)
+	if err == nil && locked.Int64 != 1 {
+		err = fmt.Errorf("timed out waiting for lock %s", jsonIndexLock)
+	}
+
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// Helper to release the json index lock and its connection.
+func (s *invService) unlockJsonIndexes(conn *sql.Conn) {
+	_, err := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", jsonIndexLock)
+	if err != nil {
+		level.Error(s.logger).Log("what", "RELEASE_LOCK", "error", err)
+	}
+
+	conn.Close()
+}
+
+// Helper to get the generated columns of the account's indexed json paths for an entity, keyed by jsonIndexKey.
+// Nothing is read unless the filter could use a json path.
+func (s *invService) jsonIndexColumns(mserviceId int64, entityName string, filter string) (map[string]string, error) {
+	indexes := make(map[string]string)
+
+	if !strings.Contains(filter, "$") {
+		return indexes, nil
+	}
+
+	sqlstring := `SELECT chvJsonPath, chvValueType, chvColumnName FROM tb_JsonIndex
+	WHERE inbMserviceId = ? AND chvEntityName = ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(mserviceId, entityName)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var path string
+		var valueType string
+		var column string
+
+		err := rows.Scan(&path, &valueType, &column)
+		if err != nil {
+			return nil, err
+		}
+
+		indexes[jsonIndexKey(path, valueType)] = column
+	}
+
+	return indexes, rows.Err()
+}
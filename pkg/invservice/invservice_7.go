@@ -0,0 +1,252 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-kit/kit/log/level"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+// maximum number of operations in a batch
+const maxBatchOperations = 500
+
+// entity kind expected by each id field that can refer to an entity created earlier in a batch
+var batchRefKinds = map[string]string{
+	"facility_id":       "facility",
+	"parent_subarea_id": "subarea",
+	"subarea_id":        "subarea",
+	"product_id":        "product",
+	"inventory_item_id": "inventory_item",
+}
+
+// entity created by an operation of a batch
+type batchCreated struct {
+	kind string
+	id   int64
+}
+
+// run an ordered list of create, update and delete operations in a single transaction
+func (s *invService) Batch(ctx context.Context, req *pb.BatchRequest) (*pb.BatchResponse, error) {
+	resp := &pb.BatchResponse{}
+
+	if len(req.GetOperations()) == 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "operations missing"
+		return resp, nil
+	}
+
+	if len(req.GetOperations()) > maxBatchOperations {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = fmt.Sprintf("batch has more than %d operations", maxBatchOperations)
+		return resp, nil
+	}
+
+	tx, err := s.pool.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Begin failed"
+		return resp, nil
+	}
+
+	defer tx.Rollback()
+
+	// the same service, preparing its statements on the transaction
+	txs := &invService{logger: s.logger, db: tx, pool: s.pool, startSecs: s.startSecs,
+		idempotencyWindow: s.idempotencyWindow, purgeRetention: s.purgeRetention, jsonIndexDdl: s.jsonIndexDdl}
+
+	created := make([]batchCreated, len(req.GetOperations()))
+	results := make([]*pb.BatchResult, 0, len(req.GetOperations()))
+
+	for i, op := range req.GetOperations() {
+		result, kind := txs.runBatchOperation(ctx, req.GetMserviceId(), op, created[:i])
+		if result.GetErrorCode() != 0 {
+			resp.ErrorCode = result.GetErrorCode()
+			resp.ErrorMessage = fmt.Sprintf("operation %d failed, batch rolled back: %s", i+1, result.GetErrorMessage())
+			resp.ErrorReason = result.GetErrorReason()
+			resp.FailedOperation = int32(i + 1)
+			return resp, nil
+		}
+
+		if kind != "" {
+			created[i] = batchCreated{kind: kind, id: result.GetId()}
+		}
+
+		results = append(results, result)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Commit", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	resp.Results = results
+
+	return resp, nil
+}
+
+// Helper to run one operation of a batch in the account of the batch, after resolving references to earlier creates.
+// Returns the kind of entity created, empty if the operation is not a create.
+func (s *invService) runBatchOperation(ctx context.Context, mserviceId int64, op *pb.BatchOperation,
+	created []batchCreated) (*pb.BatchResult, string) {
+
+	result := &pb.BatchResult{}
+
+	msg := resolveBatchRefs(op, created)
+	if msg != "" {
+		result.ErrorCode = 510
+		result.ErrorMessage = msg
+		return result, ""
+	}
+
+	kind := ""
+
+	switch x := op.GetOperation().(type) {
+	case *pb.BatchOperation_CreateFacility:
+		x.CreateFacility.MserviceId = mserviceId
+		resp, _ := s.CreateFacility(ctx, x.CreateFacility)
+		result = batchResult(resp, resp.GetFacilityId(), resp.GetVersion())
+		kind = "facility"
+
+	case *pb.BatchOperation_UpdateFacility:
+		x.UpdateFacility.MserviceId = mserviceId
+		resp, _ := s.UpdateFacility(ctx, x.UpdateFacility)
+		result = batchResult(resp, x.UpdateFacility.GetFacilityId(), resp.GetVersion())
+
+	case *pb.BatchOperation_DeleteFacility:
+		x.DeleteFacility.MserviceId = mserviceId
+		resp, _ := s.DeleteFacility(ctx, x.DeleteFacility)
+		result = batchResult(resp, x.DeleteFacility.GetFacilityId(), resp.GetVersion())
+
+	case *pb.BatchOperation_CreateSubarea:
+		x.CreateSubarea.MserviceId = mserviceId
+		resp, _ := s.CreateSubarea(ctx, x.CreateSubarea)
+		result = batchResult(resp, resp.GetSubareaId(), resp.GetVersion())
+		kind = "subarea"
+
+	case *pb.BatchOperation_UpdateSubarea:
+		x.UpdateSubarea.MserviceId = mserviceId
+		resp, _ := s.UpdateSubarea(ctx, x.UpdateSubarea)
+		result = batchResult(resp, x.UpdateSubarea.GetSubareaId(), resp.GetVersion())
+
+	case *pb.BatchOperation_DeleteSubarea:
+		x.DeleteSubarea.MserviceId = mserviceId
+		resp, _ := s.DeleteSubarea(ctx, x.DeleteSubarea)
+		result = batchResult(resp, x.DeleteSubarea.GetSubareaId(), resp.GetVersion())
+
+	case *pb.BatchOperation_CreateProduct:
+		x.CreateProduct.MserviceId = mserviceId
+		resp, _ := s.CreateProduct(ctx, x.CreateProduct)
+		result = batchResult(resp, resp.GetProductId(), resp.GetVersion())
+		kind = "product"
+
+	case *pb.BatchOperation_UpdateProduct:
+		x.UpdateProduct.MserviceId = mserviceId
+		resp, _ := s.UpdateProduct(ctx, x.UpdateProduct)
+		result = batchResult(resp, x.UpdateProduct.GetProductId(), resp.GetVersion())
+
+	case *pb.BatchOperation_DeleteProduct:
+		x.DeleteProduct.MserviceId = mserviceId
+		resp, _ := s.DeleteProduct(ctx, x.DeleteProduct)
+		result = batchResult(resp, x.DeleteProduct.GetProductId(), resp.GetVersion())
+
+	case *pb.BatchOperation_CreateInventoryItem:
+		x.CreateInventoryItem.MserviceId = mserviceId
+		resp, _ := s.CreateInventoryItem(ctx, x.CreateInventoryItem)
+		result = batchResult(resp, resp.GetInventoryItemId(), resp.GetVersion())
+		kind = "inventory_item"
+
+	case *pb.BatchOperation_UpdateInventoryItem:
+		x.UpdateInventoryItem.MserviceId = mserviceId
+		resp, _ := s.UpdateInventoryItem(ctx, x.UpdateInventoryItem)
+		result = batchResult(resp, x.UpdateInventoryItem.GetInventoryItemId(),
+			resp.GetVersion())
+
+	case *pb.BatchOperation_DeleteInventoryItem:
+		x.DeleteInventoryItem.MserviceId = mserviceId
+		resp, _ := s.DeleteInventoryItem(ctx, x.DeleteInventoryItem)
+		result = batchResult(resp, x.DeleteInventoryItem.GetInventoryItemId(),
+			resp.GetVersion())
+
+	default:
+		result.ErrorCode = 510
+		result.ErrorMessage = "operation missing"
+	}
+
+	return result, kind
+}
+
+// The error code, message and reason common to the responses of the methods a batch runs.
+type batchResponse interface {
+	GetErrorCode() int32
+	GetErrorMessage() string
+	GetErrorReason() string
+}
+
+// Helper to make the result of a batch operation from its response.
+func batchResult(resp batchResponse, id int64, version int32) *pb.BatchResult {
+	return &pb.BatchResult{ErrorCode: resp.GetErrorCode(), ErrorMessage: resp.GetErrorMessage(),
+		ErrorReason: resp.GetErrorReason(), Id: id, Version: version}
+}
+
+// Helper to replace each negative id -n in a batch operation with the id created by operation n, which must be an
+// earlier create of the kind of entity the id field names.
+func resolveBatchRefs(op *pb.BatchOperation, created []batchCreated) string {
+	opMsg := op.ProtoReflect()
+	oneof := opMsg.Descriptor().Oneofs().ByName("operation")
+	opField := opMsg.WhichOneof(oneof)
+	if opField == nil {
+		return ""
+	}
+
+	msg := opMsg.Get(opField).Message()
+	fields := msg.Descriptor().Fields()
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		kind, ok := batchRefKinds[fd.TextName()]
+		if !ok || (fd.Kind() != protoreflect.Int64Kind) {
+			continue
+		}
+
+		ref := msg.Get(fd).Int()
+		if ref >= 0 {
+			continue
+		}
+
+		n := -ref
+		if (n > int64(len(created))) || (created[n-1].kind != kind) {
+			article := "a"
+			if strings.ContainsRune("aeiou", rune(kind[0])) {
+				article = "an"
+			}
+
+			return fmt.Sprintf("%s %d does not refer to an earlier create of %s %s in this batch", fd.TextName(), ref,
+				article, strings.ReplaceAll(kind, "_", " "))
+		}
+
+		msg.Set(fd, protoreflect.ValueOfInt64(created[n-1].id))
+	}
+
+	return ""
+}
@@ -0,0 +1,91 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"testing"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+func TestResolveBatchRefs(t *testing.T) {
+	// operations 1 to 3 created a facility, a subarea and a product, operation 4 was an update
+	created := []batchCreated{{"facility", 100}, {"subarea", 200}, {"product", 300}, {}}
+
+	createItem := func(subareaId int64, productId int64) *pb.BatchOperation {
+		return &pb.BatchOperation{Operation: &pb.BatchOperation_CreateInventoryItem{
+			CreateInventoryItem: &pb.CreateInventoryItemRequest{SubareaId: subareaId, ProductId: productId},
+		}}
+	}
+
+	tests := []struct {
+		name      string
+		op        *pb.BatchOperation
+		created   []batchCreated
+		subareaId int64
+		productId int64
+		msg       string
+	}{
+		{"references", createItem(-2, -3), created, 200, 300, ""},
+		{"ids kept", createItem(5, 6), created, 5, 6, ""},
+		{"mixed", createItem(-2, 6), created, 200, 6, ""},
+		{"wrong kind", createItem(-1, 6), created, 0, 0,
+			"subarea_id -1 does not refer to an earlier create of a subarea in this batch"},
+		{"not a create", createItem(-4, 6), created, 0, 0,
+			"subarea_id -4 does not refer to an earlier create of a subarea in this batch"},
+		{"forward", createItem(-2, -5), created, 0, 0,
+			"product_id -5 does not refer to an earlier create of a product in this batch"},
+		{"self", createItem(-2, -3), created[:2], 0, 0,
+			"product_id -3 does not refer to an earlier create of a product in this batch"},
+		{"first operation", createItem(-1, 0), nil, 0, 0,
+			"subarea_id -1 does not refer to an earlier create of a subarea in this batch"},
+	}
+
+	for _, tt := range tests {
+		msg := resolveBatchRefs(tt.op, tt.created)
+		if msg != tt.msg {
+			t.Errorf("%s: message = %q, want %q", tt.name, msg, tt.msg)
+			continue
+		}
+
+		item := tt.op.GetCreateInventoryItem()
+		if (msg == "") && ((item.GetSubareaId() != tt.subareaId) || (item.GetProductId() != tt.productId)) {
+			t.Errorf("%s: resolved to %d, %d, want %d, %d", tt.name, item.GetSubareaId(), item.GetProductId(),
+				tt.subareaId, tt.productId)
+		}
+	}
+
+	// the parent of a subarea is a subarea, so a facility cannot stand in for it
+	op := &pb.BatchOperation{Operation: &pb.BatchOperation_CreateSubarea{
+		CreateSubarea: &pb.CreateSubareaRequest{FacilityId: -1, ParentSubareaId: -1},
+	}}
+
+	msg := resolveBatchRefs(op, created)
+	if msg != "parent_subarea_id -1 does not refer to an earlier create of a subarea in this batch" {
+		t.Errorf("parent facility reference: %q", msg)
+	}
+
+	op = &pb.BatchOperation{Operation: &pb.BatchOperation_DeleteInventoryItem{
+		DeleteInventoryItem: &pb.DeleteInventoryItemRequest{InventoryItemId: -3},
+	}}
+
+	msg = resolveBatchRefs(op, created)
+	if msg != "inventory_item_id -3 does not refer to an earlier create of an inventory item in this batch" {
+		t.Errorf("item reference to product: %q", msg)
+	}
+
+	if msg := resolveBatchRefs(&pb.BatchOperation{}, created); msg != "" {
+		t.Errorf("empty operation: %q", msg)
+	}
+}
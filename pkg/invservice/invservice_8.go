@@ -0,0 +1,263 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"database/sql"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+// Streams send each row as it is read from the database cursor, so the result never has to fit in memory.
+// An error ends the stream with a message carrying only the error code and message. If the client goes away,
+// the stream context is cancelled, which also cancels the query.
+
+// stream all subareas by facility
+func (s *invService) StreamSubareas(req *pb.StreamSubareasRequest, stream pb.MServiceInventory_StreamSubareasServer) error {
+	ctx := stream.Context()
+
+	sqlstring := `SELECT s.inbSubareaId, s.dtmCreated, s.dtmModified, s.intVersion, s.inbMserviceId, s.inbFacilityId,
+	s.inbParentSubareaId, s.intPosition, s.intSubareaTypeId, s.chvSubareaName, s.chvJsonData, f.chvFacilityName, t.chvSubareaTypeName
+	FROM tb_Subarea AS s
+	LEFT JOIN tb_Facility AS f ON s.inbFacilityId = f.inbFacilityId
+	LEFT JOIN tb_SubareaType AS t ON s.inbMserviceId = t.inbMserviceId AND s.intSubareaTypeId = t.intSubareaTypeId
+	WHERE s.inbMserviceId = ? AND s.inbFacilityId = ? AND s.bitIsDeleted = 0
+	ORDER BY s.inbParentSubareaId, s.intPosition`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		return stream.Send(&pb.StreamSubareasResponse{ErrorCode: 500, ErrorMessage: "db.Prepare failed"})
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, req.GetMserviceId(), req.GetFacilityId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		return stream.Send(&pb.StreamSubareasResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var created string
+		var modified string
+		var subarea pb.Subarea
+		var facility sql.NullString
+		var subtype sql.NullString
+
+		err := rows.Scan(&subarea.SubareaId, &created, &modified, &subarea.Version, &subarea.MserviceId,
+			&subarea.FacilityId, &subarea.ParentSubareaId, &subarea.Position, &subarea.SubareaTypeId,
+			&subarea.SubareaName, &subarea.JsonData, &facility, &subtype)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			return stream.Send(&pb.StreamSubareasResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+		}
+
+		subarea.Created = dml.DateTimeFromString(created)
+		subarea.Modified = dml.DateTimeFromString(modified)
+		if facility.Valid {
+			subarea.FacilityName = facility.String
+		}
+		if subtype.Valid {
+			subarea.SubareaTypeName = subtype.String
+		}
+
+		err = stream.Send(&pb.StreamSubareasResponse{Subarea: &subarea})
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if err := rows.Err(); err != nil {
+		level.Error(s.logger).Log("what", "Next", "error", err)
+		return stream.Send(&pb.StreamSubareasResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+	}
+
+	return nil
+}
+
+// stream all products by mservice_id
+func (s *invService) StreamProducts(req *pb.StreamProductsRequest, stream pb.MServiceInventory_StreamProductsServer) error {
+	ctx := stream.Context()
+
+	indexes, err := s.jsonIndexColumns(req.GetMserviceId(), "product", req.GetFilter())
+	if err != nil {
+		level.Error(s.logger).Log("what", "jsonIndexColumns", "error", err)
+		return stream.Send(&pb.StreamProductsResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+	}
+
+	opts, msg := parseListOptions(req.GetFilter(), req.GetOrderBy(), 0, "", productFields, indexes)
+	if msg != "" {
+		return stream.Send(&pb.StreamProductsResponse{ErrorCode: 510, ErrorMessage: msg})
+	}
+
+	fromWhere := `FROM tb_Product WHERE inbMserviceId = ? AND bitIsDeleted = 0`
+	fromWhere += opts.where
+	args := append([]interface{}{req.GetMserviceId()}, opts.args...)
+	orderClause, args := opts.page.clause("inbProductId", opts.order, args)
+
+	sqlstring := `SELECT inbProductId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvSku, chvProductName,
+	chvComment, chvJsonData
+	` + fromWhere + orderClause
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		return stream.Send(&pb.StreamProductsResponse{ErrorCode: 500, ErrorMessage: "db.Prepare failed"})
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		return stream.Send(&pb.StreamProductsResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var created string
+		var modified string
+		var product pb.Product
+
+		err := rows.Scan(&product.ProductId, &created, &modified, &product.Version,
+			&product.MserviceId, &product.Sku, &product.ProductName, &product.Comment, &product.JsonData)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			return stream.Send(&pb.StreamProductsResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+		}
+
+		product.Created = dml.DateTimeFromString(created)
+		product.Modified = dml.DateTimeFromString(modified)
+
+		err = stream.Send(&pb.StreamProductsResponse{Product: &product})
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if err := rows.Err(); err != nil {
+		level.Error(s.logger).Log("what", "Next", "error", err)
+		return stream.Send(&pb.StreamProductsResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+	}
+
+	return nil
+}
+
+// stream all inventory items by facility
+func (s *invService) StreamInventoryItemsByFacility(req *pb.StreamInventoryItemsByFacilityRequest,
+	stream pb.MServiceInventory_StreamInventoryItemsByFacilityServer) error {
+
+	ctx := stream.Context()
+
+	indexes, err := s.jsonIndexColumns(req.GetMserviceId(), "inventoryitem", req.GetFilter())
+	if err != nil {
+		level.Error(s.logger).Log("what", "jsonIndexColumns", "error", err)
+		return stream.Send(&pb.StreamInventoryItemsByFacilityResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+	}
+
+	opts, msg := parseListOptions(req.GetFilter(), req.GetOrderBy(), 0, "", inventoryItemFields, indexes)
+	if msg != "" {
+		return stream.Send(&pb.StreamInventoryItemsByFacilityResponse{ErrorCode: 510, ErrorMessage: msg})
+	}
+
+	fromWhere := `FROM tb_InventoryItem AS i
+	JOIN tb_Subarea AS a ON i.inbSubareaId = a.inbSubareaId
+	LEFT JOIN tb_ItemType as t ON  i.inbMserviceId = t.inbMserviceId AND i.intItemTypeId = t.intItemTypeId
+	LEFT JOIN tb_Product as p ON i.inbProductId = p.inbProductId
+	WHERE a.inbFacilityId = ? AND i.inbMserviceId = ? AND i.bitIsDeleted = 0`
+	fromWhere += opts.where
+	args := append([]interface{}{req.GetFacilityId(), req.GetMserviceId()}, opts.args...)
+	orderClause, args := opts.page.clause("i.inbInventoryItemId", opts.order, args)
+
+	sqlstring := `SELECT i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.intVersion, i.inbMserviceId,
+	i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId, i.chvJsonData,
+	t.chvItemTypeName, p.chvProductName
+	` + fromWhere + orderClause
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		return stream.Send(&pb.StreamInventoryItemsByFacilityResponse{ErrorCode: 500, ErrorMessage: "db.Prepare failed"})
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		return stream.Send(&pb.StreamInventoryItemsByFacilityResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var created string
+		var modified string
+		var item pb.InventoryItem
+		var typeName sql.NullString
+		var productName sql.NullString
+
+		err := rows.Scan(&item.InventoryItemId, &created, &modified,
+			&item.Version, &item.MserviceId, &item.SubareaId, &item.ItemTypeId, &item.Quantity, &item.SerialNumber,
+			&item.ProductId, &item.JsonData, &typeName, &productName)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			return stream.Send(&pb.StreamInventoryItemsByFacilityResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+		}
+
+		item.Created = dml.DateTimeFromString(created)
+		item.Modified = dml.DateTimeFromString(modified)
+		if typeName.Valid {
+			item.ItemTypeName = typeName.String
+		}
+		if productName.Valid {
+			item.ProductName = productName.String
+		}
+
+		err = stream.Send(&pb.StreamInventoryItemsByFacilityResponse{InventoryItem: &item})
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if err := rows.Err(); err != nil {
+		level.Error(s.logger).Log("what", "Next", "error", err)
+		return stream.Send(&pb.StreamInventoryItemsByFacilityResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+	}
+
+	return nil
+}
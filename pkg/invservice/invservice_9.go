@@ -0,0 +1,621 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+// types of inventory item event
+const (
+	itemEventCreate   = "create"
+	itemEventUpdate   = "update"
+	itemEventDelete   = "delete"
+	itemEventUndelete = "undelete"
+)
+
+// interval between reads of the event table by a watch
+const watchPollInterval = time.Second
+
+// interval after which an idle watch sends a heartbeat message with its resume token
+const watchHeartbeatInterval = 30 * time.Second
+
+// maximum number of events read at a time by a watch
+const watchPollLimit = 500
+
+// Event ids are allocated when the event is inserted but become visible when its transaction commits, so a watch
+// can see an event before one with a lower id. A watch keeps looking for each skipped id for this long, after which
+// it is taken to belong to a rolled back transaction.
+const watchGapTimeout = time.Minute
+
+// maximum number of skipped event ids a watch keeps looking for
+const maxWatchGaps = 1000
+
+// position of a watch in the event table, carried between watches as an opaque resume token
+type resumeToken struct {
+	LastId int64   `json:"id"`
+	Gaps   []int64 `json:"g,omitempty"`
+}
+
+// inventory item event as read from the event table
+type itemEvent struct {
+	eventId        int64
+	created        string
+	mserviceId     int64
+	eventType      string
+	itemId         int64
+	version        int32
+	facilityId     int64
+	subareaId      int64
+	productId      int64
+	prevFacilityId int64
+	prevSubareaId  int64
+	prevProductId  int64
+}
+
+// state of a running watch
+type inventoryWatch struct {
+	mserviceId int64
+	facilityId int64
+	subareaId  int64
+	productId  int64
+	lastId     int64
+	gaps       map[int64]time.Time
+}
+
+// watch create, update and delete events for the inventory items of a facility, subarea subtree or product
+func (s *invService) WatchInventory(req *pb.WatchInventoryRequest, stream pb.MServiceInventory_WatchInventoryServer) error {
+	ctx := stream.Context()
+
+	scopes := 0
+	for _, id := range []int64{req.GetFacilityId(), req.GetSubareaId(), req.GetProductId()} {
+		if id != 0 {
+			scopes++
+		}
+	}
+
+	if scopes != 1 {
+		return stream.Send(&pb.WatchInventoryResponse{ErrorCode: 510,
+			ErrorMessage: "exactly one of facility_id, subarea_id or product_id required"})
+	}
+
+	w := &inventoryWatch{mserviceId: req.GetMserviceId(), productId: req.GetProductId(), gaps: make(map[int64]time.Time)}
+
+	errCode, errMsg := s.checkWatchScope(ctx, req, w)
+	if errCode != 0 {
+		return stream.Send(&pb.WatchInventoryResponse{ErrorCode: errCode, ErrorMessage: errMsg})
+	}
+
+	if req.GetResumeToken() != "" {
+		msg := w.resume(req.GetResumeToken())
+		if msg != "" {
+			return stream.Send(&pb.WatchInventoryResponse{ErrorCode: 510, ErrorMessage: msg})
+		}
+
+		firstId, err := firstItemEventId(s.db)
+		if err != nil {
+			level.Error(s.logger).Log("what", "firstItemEventId", "error", err)
+			return stream.Send(&pb.WatchInventoryResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+		}
+
+		if w.expired(firstId) {
+			return stream.Send(&pb.WatchInventoryResponse{ErrorCode: 510, ErrorMessage: "resume_token expired"})
+		}
+	} else {
+		lastId, err := lastItemEventId(s.db)
+		if err != nil {
+			level.Error(s.logger).Log("what", "lastItemEventId", "error", err)
+			return stream.Send(&pb.WatchInventoryResponse{ErrorCode: 500, ErrorMessage: err.Error()})
+		}
+
+		w.lastId = lastId
+	}
+
+	// let the client know the watch has started and where it can resume from
+	err := stream.Send(&pb.WatchInventoryResponse{ResumeToken: w.token()})
+	if err != nil {
+		return err
+	}
+
+	lastSent := time.Now()
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		sent, err := s.pollWatch(ctx, w, stream)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "pollWatch", "error", err)
+			return stream.Send(&pb.WatchInventoryResponse{ErrorCode: 500, ErrorMessage: err.Error(), ResumeToken: w.token()})
+		}
+
+		if sent {
+			lastSent = time.Now()
+		} else if time.Since(lastSent) >= watchHeartbeatInterval {
+			err = stream.Send(&pb.WatchInventoryResponse{ResumeToken: w.token()})
+			if err != nil {
+				return err
+			}
+
+			lastSent = time.Now()
+		}
+	}
+}
+
+// Helper to check that the facility, subarea or product watched exists in the account.
+// A subarea is watched through the events of its facility, so the facility is set in the watch.
+func (s *invService) checkWatchScope(ctx context.Context, req *pb.WatchInventoryRequest, w *inventoryWatch) (int32, string) {
+	switch {
+	case req.GetFacilityId() != 0:
+		resp, _ := s.GetFacilityHelper(req.GetMserviceId(), req.GetFacilityId(), false)
+		w.facilityId = req.GetFacilityId()
+		return resp.ErrorCode, resp.ErrorMessage
+
+	case req.GetSubareaId() != 0:
+		resp, _ := s.GetSubarea(ctx, &pb.GetSubareaRequest{MserviceId: req.GetMserviceId(), SubareaId: req.GetSubareaId()})
+		w.facilityId = resp.GetSubarea().GetFacilityId()
+		w.subareaId = req.GetSubareaId()
+		return resp.GetErrorCode(), resp.GetErrorMessage()
+
+	default:
+		resp, _ := s.GetProduct(ctx, &pb.GetProductRequest{MserviceId: req.GetMserviceId(), ProductId: req.GetProductId()})
+		return resp.GetErrorCode(), resp.GetErrorMessage()
+	}
+}
+
+// Helper to read the events recorded since the last poll and send those the watch is interested in.
+// Returns true if any message was sent.
+func (s *invService) pollWatch(ctx context.Context, w *inventoryWatch, stream pb.MServiceInventory_WatchInventoryServer) (bool, error) {
+	events, err := readItemEvents(ctx, s.db, w.lastId, w.gapIds())
+	if err != nil {
+		return false, err
+	}
+
+	w.expireGaps()
+
+	if len(events) == 0 {
+		return false, nil
+	}
+
+	var subtree map[int64]bool
+	if w.subareaId != 0 {
+		subtree, err = s.subareaSubtree(w.mserviceId, w.facilityId, w.subareaId)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	matched := make([]*itemEvent, 0)
+	for _, event := range events {
+		if w.matches(event, subtree) {
+			matched = append(matched, event)
+		}
+	}
+
+	items, err := s.getEventItems(w.mserviceId, matched)
+	if err != nil {
+		return false, err
+	}
+
+	sent := false
+	for _, event := range events {
+		w.advance(event.eventId)
+		if !w.matches(event, subtree) {
+			continue
+		}
+
+		ev := &pb.InventoryEvent{}
+		ev.EventId = event.eventId
+		ev.Created = dml.DateTimeFromString(event.created)
+		ev.EventType = event.eventType
+		ev.InventoryItemId = event.itemId
+		ev.Version = event.version
+		ev.InventoryItem = items[event.itemId]
+
+		err = stream.Send(&pb.WatchInventoryResponse{Event: ev, ResumeToken: w.token()})
+		if err != nil {
+			return sent, err
+		}
+
+		sent = true
+	}
+
+	return sent, nil
+}
+
+// Helper to see if an event is for an item the watch is interested in, before or after the change.
+func (w *inventoryWatch) matches(event *itemEvent, subtree map[int64]bool) bool {
+	if event.mserviceId != w.mserviceId {
+		return false
+	}
+
+	switch {
+	case w.subareaId != 0:
+		return subtree[event.subareaId] || subtree[event.prevSubareaId]
+	case w.facilityId != 0:
+		return (event.facilityId == w.facilityId) || (event.prevFacilityId == w.facilityId)
+	default:
+		return (event.productId == w.productId) || (event.prevProductId == w.productId)
+	}
+}
+
+// Helper to move the watch past an event id, remembering any ids skipped on the way as gaps.
+func (w *inventoryWatch) advance(eventId int64) {
+	if _, ok := w.gaps[eventId]; ok {
+		delete(w.gaps, eventId)
+		return
+	}
+
+	if eventId <= w.lastId {
+		return
+	}
+
+	first := w.lastId + 1
+	if eventId-first > maxWatchGaps {
+		first = eventId - maxWatchGaps
+	}
+
+	now := time.Now()
+	for id := first; id < eventId; id++ {
+		w.gaps[id] = now
+	}
+
+	w.lastId = eventId
+
+	if len(w.gaps) > maxWatchGaps {
+		for _, id := range w.gapIds()[:len(w.gaps)-maxWatchGaps] {
+			delete(w.gaps, id)
+		}
+	}
+}
+
+// Helper to stop looking for skipped event ids that have not appeared within the gap timeout.
+func (w *inventoryWatch) expireGaps() {
+	for id, seen := range w.gaps {
+		if time.Since(seen) > watchGapTimeout {
+			delete(w.gaps, id)
+		}
+	}
+}
+
+// Helper to get the skipped event ids of the watch in order.
+func (w *inventoryWatch) gapIds() []int64 {
+	ids := make([]int64, 0, len(w.gaps))
+	for id := range w.gaps {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
+
+// Helper to encode the position of the watch as a resume token.
+func (w *inventoryWatch) token() string {
+	buf, _ := json.Marshal(resumeToken{LastId: w.lastId, Gaps: w.gapIds()})
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// Helper to continue a watch from a resume token, which restarts the timeout of its gaps.
+func (w *inventoryWatch) resume(token string) string {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "resume_token invalid"
+	}
+
+	var rt resumeToken
+	err = json.Unmarshal(buf, &rt)
+	if (err != nil) || (rt.LastId < 0) || (len(rt.Gaps) > maxWatchGaps) {
+		return "resume_token invalid"
+	}
+
+	w.lastId = rt.LastId
+	now := time.Now()
+	for _, id := range rt.Gaps {
+		if (id <= 0) || (id >= rt.LastId) {
+			return "resume_token invalid"
+		}
+
+		w.gaps[id] = now
+	}
+
+	return ""
+}
+
+// Helper to tell whether events the watch has not seen yet may have been purged, given the id of the oldest event
+// left. An id skipped by a rolled back transaction cannot be told apart from a purged event, so it also expires the
+// watch, which then has to read the inventory again.
+func (w *inventoryWatch) expired(firstId int64) bool {
+	if firstId == 0 {
+		return false
+	}
+
+	if w.lastId < firstId-1 {
+		return true
+	}
+
+	for id := range w.gaps {
+		if id < firstId {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper to record an inventory item event, after the item has been written in the same transaction.
+// The previous subarea and product are those of the item before an update, and zero for a create or delete.
+func recordItemEvent(q dbQueryer, mserviceId int64, itemId int64, eventType string, prevSubareaId int64, prevProductId int64) error {
+	sqlstring := `INSERT INTO tb_InventoryEvent (dtmCreated, inbMserviceId, chvEventType, inbInventoryItemId, intVersion,
+	inbFacilityId, inbSubareaId, inbProductId, inbPrevFacilityId, inbPrevSubareaId, inbPrevProductId)
+	SELECT NOW(), i.inbMserviceId, ?, i.inbInventoryItemId, i.intVersion, COALESCE(a.inbFacilityId, 0), i.inbSubareaId,
+	i.inbProductId, COALESCE(p.inbFacilityId, 0), ?, ?
+	FROM tb_InventoryItem AS i
+	LEFT JOIN tb_Subarea AS a ON i.inbSubareaId = a.inbSubareaId
+	LEFT JOIN tb_Subarea AS p ON p.inbSubareaId = ?
+	WHERE i.inbInventoryItemId = ? AND i.inbMserviceId = ?`
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(eventType, prevSubareaId, prevProductId, prevSubareaId, itemId, mserviceId)
+
+	return err
+}
+
+// Helper to get the subarea and product of a live inventory item before it is updated, locking it in the transaction.
+// Returns zeros if the item is not found, which the update then reports.
+func getItemPlacement(q dbQueryer, mserviceId int64, itemId int64) (int64, int64, error) {
+	var subareaId int64
+	var productId int64
+
+	sqlstring := `SELECT inbSubareaId, inbProductId FROM tb_InventoryItem
+	WHERE inbInventoryItemId = ? AND inbMserviceId = ? AND bitIsDeleted = 0 FOR UPDATE`
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer stmt.Close()
+
+	err = stmt.QueryRow(itemId, mserviceId).Scan(&subareaId, &productId)
+	if err == sql.ErrNoRows {
+		return 0, 0, nil
+	}
+
+	return subareaId, productId, err
+}
+
+// Helper to get the id of the last event recorded, where a new watch starts.
+func lastItemEventId(q dbQueryer) (int64, error) {
+	var lastId int64
+
+	stmt, err := q.Prepare(`SELECT COALESCE(MAX(inbEventId), 0) FROM tb_InventoryEvent`)
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	err = stmt.QueryRow().Scan(&lastId)
+
+	return lastId, err
+}
+
+// Helper to get the id of the oldest event left after purging, zero if there is none.
+func firstItemEventId(q dbQueryer) (int64, error) {
+	var firstId int64
+
+	stmt, err := q.Prepare(`SELECT COALESCE(MIN(inbEventId), 0) FROM tb_InventoryEvent`)
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	err = stmt.QueryRow().Scan(&firstId)
+
+	return firstId, err
+}
+
+// Helper to read the events of every account after an event id, and those with the given skipped ids, in id order.
+// Every id is read so that the watch can tell which ids it has not seen yet.
+func readItemEvents(ctx context.Context, q dbQueryer, lastId int64, gaps []int64) ([]*itemEvent, error) {
+	where := "inbEventId > ?"
+	args := []interface{}{lastId}
+	if len(gaps) > 0 {
+		where = "(inbEventId > ? OR inbEventId IN (?" + strings.Repeat(", ?", len(gaps)-1) + "))"
+		for _, id := range gaps {
+			args = append(args, id)
+		}
+	}
+
+	sqlstring := `SELECT inbEventId, dtmCreated, inbMserviceId, chvEventType, inbInventoryItemId, intVersion, inbFacilityId,
+	inbSubareaId, inbProductId, inbPrevFacilityId, inbPrevSubareaId, inbPrevProductId
+	FROM tb_InventoryEvent WHERE ` + where + ` ORDER BY inbEventId LIMIT ?`
+	args = append(args, watchPollLimit)
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	events := make([]*itemEvent, 0)
+	for rows.Next() {
+		var event itemEvent
+		err = rows.Scan(&event.eventId, &event.created, &event.mserviceId, &event.eventType, &event.itemId,
+			&event.version, &event.facilityId, &event.subareaId, &event.productId, &event.prevFacilityId,
+			&event.prevSubareaId, &event.prevProductId)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, rows.Err()
+}
+
+// Helper to get the ids of a subarea and every live subarea nested in it.
+func (s *invService) subareaSubtree(mserviceId int64, facilityId int64, subareaId int64) (map[int64]bool, error) {
+	sqlstring := `SELECT inbSubareaId, inbParentSubareaId FROM tb_Subarea
+	WHERE inbFacilityId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(facilityId, mserviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	children := make(map[int64][]int64)
+	for rows.Next() {
+		var id int64
+		var parentId int64
+		err = rows.Scan(&id, &parentId)
+		if err != nil {
+			return nil, err
+		}
+
+		children[parentId] = append(children[parentId], id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	subtree := map[int64]bool{subareaId: true}
+	pending := []int64{subareaId}
+	for len(pending) > 0 {
+		id := pending[0]
+		pending = pending[1:]
+		for _, child := range children[id] {
+			if !subtree[child] {
+				subtree[child] = true
+				pending = append(pending, child)
+			}
+		}
+	}
+
+	return subtree, nil
+}
+
+// Helper to get the current state of the items of a list of events, including deleted items.
+func (s *invService) getEventItems(mserviceId int64, events []*itemEvent) (map[int64]*pb.InventoryItem, error) {
+	items := make(map[int64]*pb.InventoryItem)
+	if len(events) == 0 {
+		return items, nil
+	}
+
+	args := []interface{}{mserviceId}
+	for _, event := range events {
+		args = append(args, event.itemId)
+	}
+
+	sqlstring := `SELECT i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.dtmDeleted, i.bitIsDeleted, i.intVersion,
+	i.inbMserviceId, i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId, i.chvJsonData,
+	t.chvItemTypeName, p.chvProductName
+	FROM tb_InventoryItem AS i
+	LEFT JOIN tb_ItemType as t ON  i.inbMserviceId = t.inbMserviceId AND i.intItemTypeId = t.intItemTypeId
+	LEFT JOIN tb_Product as p ON i.inbProductId = p.inbProductId
+	WHERE i.inbMserviceId = ? AND i.inbInventoryItemId IN (?` + strings.Repeat(", ?", len(events)-1) + `)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var created string
+		var modified string
+		var deleted string
+		var item pb.InventoryItem
+		var typeName sql.NullString
+		var productName sql.NullString
+
+		err = rows.Scan(&item.InventoryItemId, &created, &modified, &deleted, &item.IsDeleted, &item.Version,
+			&item.MserviceId, &item.SubareaId, &item.ItemTypeId, &item.Quantity, &item.SerialNumber, &item.ProductId,
+			&item.JsonData, &typeName, &productName)
+		if err != nil {
+			return nil, err
+		}
+
+		item.Created = dml.DateTimeFromString(created)
+		item.Modified = dml.DateTimeFromString(modified)
+		if item.IsDeleted {
+			item.Deleted = dml.DateTimeFromString(deleted)
+		}
+		if typeName.Valid {
+			item.ItemTypeName = typeName.String
+		}
+		if productName.Valid {
+			item.ProductName = productName.String
+		}
+
+		items[item.InventoryItemId] = &item
+	}
+
+	return items, rows.Err()
+}
@@ -15,28 +15,64 @@ package invservice
 
 import (
 	"database/sql"
+	"fmt"
 
 	"github.com/go-kit/kit/log/level"
 
 	"github.com/gaterace/dml-go/pkg/dml"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
 )
 
+// Prepare is common to *sql.DB and *sql.Tx, so helpers taking a dbQueryer can run inside or outside a transaction.
+// The service itself prepares its statements on a dbQueryer, which is the transaction while running a batch,
+// and uses the connection pool directly only to begin transactions of its own.
+type dbQueryer interface {
+	Prepare(query string) (*sql.Stmt, error)
+}
+
 // reusable response struct for specific method response generation.
 type genericResponse struct {
 	ErrorCode    int32
 	ErrorMessage string
+	ErrorReason  string
+}
+
+// Reasons given in error_reason for a 510 refusing a request because of the stored data, rather than because the
+// request is invalid in itself. The server status interceptor maps each to its own gRPC status code.
+const (
+	reasonNameInUse         = "NAME_IN_USE"
+	reasonRuleExists        = "RULE_EXISTS"
+	reasonRequestIdReused   = "REQUEST_ID_REUSED"
+	reasonParentDeleted     = "PARENT_DELETED"
+	reasonNotDeleted        = "NOT_DELETED"
+	reasonNotAChild         = "NOT_A_CHILD"
+	reasonChildrenChanged   = "CHILDREN_CHANGED"
+	reasonTypeRule          = "TYPE_RULE"
+	reasonHasDependents     = "HAS_DEPENDENTS"
+	reasonNonconformingData = "NONCONFORMING_DATA"
+)
+
+// Helper to give the reason for a refusal message, empty if there is no message.
+func reasonFor(msg string, reason string) string {
+	if msg == "" {
+		return ""
+	}
+
+	return reason
 }
 
-// Helper to get the facility given the mservice account id and facility id.
-func (s *invService) GetFacilityHelper(mserviceId int64, facilityId int64) (*genericResponse, *pb.Facility) {
+// Helper to get the facility given the mservice account id and facility id, optionally even if deleted.
+func (s *invService) GetFacilityHelper(mserviceId int64, facilityId int64, includeDeleted bool) (*genericResponse, *pb.Facility) {
 	resp := &genericResponse{}
 
-	sqlstring := `SELECT inbFacilityId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvFacilityName, chvJsonData
-	FROM tb_Facility WHERE inbFacilityId= ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+	sqlstring := `SELECT inbFacilityId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+	chvFacilityName, chvJsonData
+	FROM tb_Facility WHERE inbFacilityId= ? AND inbMserviceId = ?` + liveClause("", includeDeleted)
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -50,14 +86,18 @@ func (s *invService) GetFacilityHelper(mserviceId int64, facilityId int64) (*gen
 
 	var created string
 	var modified string
+	var deleted string
 	var facility pb.Facility
 
-	err = stmt.QueryRow(facilityId, mserviceId).Scan(&facility.FacilityId, &created, &modified,
-		&facility.Version, &facility.MserviceId, &facility.FacilityName, &facility.JsonData)
+	err = stmt.QueryRow(facilityId, mserviceId).Scan(&facility.FacilityId, &created, &modified, &deleted,
+		&facility.IsDeleted, &facility.Version, &facility.MserviceId, &facility.FacilityName, &facility.JsonData)
 
 	if err == nil {
 		facility.Created = dml.DateTimeFromString(created)
 		facility.Modified = dml.DateTimeFromString(modified)
+		if facility.IsDeleted {
+			facility.Deleted = dml.DateTimeFromString(deleted)
+		}
 		resp.ErrorCode = 0
 	} else if err == sql.ErrNoRows {
 		resp.ErrorCode = 404
@@ -73,20 +113,32 @@ func (s *invService) GetFacilityHelper(mserviceId int64, facilityId int64) (*gen
 	return resp, &facility
 }
 
-// Helper to get the subareas for a facility.
-func (s *invService) GetSubareasHelper(mserviceId int64, facilityId int64) (*genericResponse, []*pb.Subarea) {
+// Helper to get the subareas for a facility, optionally within a transaction and optionally including deleted ones.
+// With a nil or unpaged page spec all subareas are returned in parent and position order.
+func (s *invService) GetSubareasHelper(q dbQueryer, mserviceId int64, facilityId int64, page *pageSpec,
+	includeDeleted bool) (*genericResponse, []*pb.Subarea) {
+
 	resp := &genericResponse{}
 	subareas := make([]*pb.Subarea, 0)
 
-	sqlstring := `SELECT s.inbSubareaId, s.dtmCreated, s.dtmModified, s.intVersion, s.inbMserviceId, s.inbFacilityId, 
-	s.inbParentSubareaId, s.intPosition, s.intSubareaTypeId, s.chvSubareaName, s.chvJsonData, f.chvFacilityName, t.chvSubareaTypeName 
+	sqlstring := `SELECT s.inbSubareaId, s.dtmCreated, s.dtmModified, s.dtmDeleted, s.bitIsDeleted, s.intVersion, s.inbMserviceId, 
+	s.inbFacilityId, s.inbParentSubareaId, s.intPosition, s.intSubareaTypeId, s.chvSubareaName, s.chvJsonData, f.chvFacilityName, 
+	t.chvSubareaTypeName 
 	FROM tb_Subarea AS s 
 	LEFT JOIN tb_Facility AS f ON s.inbFacilityId = f.inbFacilityId
 	LEFT JOIN tb_SubareaType AS t ON s.inbMserviceId = t.inbMserviceId AND s.intSubareaTypeId = t.intSubareaTypeId
-	WHERE s.inbMserviceId = ? AND s.inbFacilityId = ? AND s.bitIsDeleted = 0
-	ORDER BY s.inbParentSubareaId, s.intPosition`
+	WHERE s.inbMserviceId = ? AND s.inbFacilityId = ?` + liveClause("s.", includeDeleted)
 
-	stmt, err := s.db.Prepare(sqlstring)
+	args := []interface{}{mserviceId, facilityId}
+	if (page != nil) && page.paged() {
+		var pageClause string
+		pageClause, args = page.clause("s.inbSubareaId", nil, args)
+		sqlstring += pageClause
+	} else {
+		sqlstring += " ORDER BY s.inbParentSubareaId, s.intPosition"
+	}
+
+	stmt, err := q.Prepare(sqlstring)
 	if err != nil {
 		level.Error(s.logger).Log("what", "Prepare", "error", err)
 		resp.ErrorCode = 500
@@ -96,7 +148,7 @@ func (s *invService) GetSubareasHelper(mserviceId int64, facilityId int64) (*gen
 
 	defer stmt.Close()
 
-	rows, err := stmt.Query(mserviceId, facilityId)
+	rows, err := stmt.Query(args...)
 
 	if err != nil {
 		level.Error(s.logger).Log("what", "Query", "error", err)
@@ -109,12 +161,13 @@ func (s *invService) GetSubareasHelper(mserviceId int64, facilityId int64) (*gen
 	for rows.Next() {
 		var created string
 		var modified string
+		var deleted string
 		var subarea pb.Subarea
 		var facility sql.NullString
 		var subtype sql.NullString
 
-		err := rows.Scan(&subarea.SubareaId, &created, &modified, &subarea.Version, &subarea.MserviceId,
-			&subarea.FacilityId, &subarea.ParentSubareaId, &subarea.Position, &subarea.SubareaTypeId,
+		err := rows.Scan(&subarea.SubareaId, &created, &modified, &deleted, &subarea.IsDeleted, &subarea.Version,
+			&subarea.MserviceId, &subarea.FacilityId, &subarea.ParentSubareaId, &subarea.Position, &subarea.SubareaTypeId,
 			&subarea.SubareaName, &subarea.JsonData, &facility, &subtype)
 
 		if err != nil {
@@ -126,6 +179,9 @@ func (s *invService) GetSubareasHelper(mserviceId int64, facilityId int64) (*gen
 
 		subarea.Created = dml.DateTimeFromString(created)
 		subarea.Modified = dml.DateTimeFromString(modified)
+		if subarea.IsDeleted {
+			subarea.Deleted = dml.DateTimeFromString(deleted)
+		}
 		if facility.Valid {
 			subarea.FacilityName = facility.String
 		}
@@ -139,6 +195,182 @@ func (s *invService) GetSubareasHelper(mserviceId int64, facilityId int64) (*gen
 	return resp, subareas
 }
 
+// Helper to tell whether an update request has an update mask naming the fields to update.
+// An empty mask, or one containing "*", updates every field.
+func hasUpdateMask(mask *fieldmaskpb.FieldMask) bool {
+	for _, path := range mask.GetPaths() {
+		if path == "*" {
+			return false
+		}
+	}
+
+	return len(mask.GetPaths()) > 0
+}
+
+// Helper to apply an update mask by copying the current value of every request field not in the mask into the request,
+// so the full update that follows writes those columns back unchanged. The mservice id, version and key field are
+// never copied, so the update still only succeeds against the version the client read.
+func applyUpdateMask(mask *fieldmaskpb.FieldMask, req proto.Message, current proto.Message, key string) string {
+	reqMsg := req.ProtoReflect()
+	curMsg := current.ProtoReflect()
+	reqFields := reqMsg.Descriptor().Fields()
+	curFields := curMsg.Descriptor().Fields()
+
+	fixed := map[string]bool{"mservice_id": true, "version": true, "update_mask": true, key: true}
+	masked := make(map[string]bool)
+
+	for _, path := range mask.GetPaths() {
+		fd := reqFields.ByTextName(path)
+		if (fd == nil) || fixed[path] || (curFields.ByTextName(path) == nil) {
+			return fmt.Sprintf("update_mask field '%s' not supported", path)
+		}
+
+		masked[path] = true
+	}
+
+	for i := 0; i < reqFields.Len(); i++ {
+		fd := reqFields.Get(i)
+		name := fd.TextName()
+		if fixed[name] || masked[name] {
+			continue
+		}
+
+		cfd := curFields.ByTextName(name)
+		if (cfd == nil) || (cfd.Kind() != fd.Kind()) {
+			continue
+		}
+
+		reqMsg.Set(fd, curMsg.Get(cfd))
+	}
+
+	return ""
+}
+
+// Helper to get the condition that leaves out deleted rows of a table, given its alias with a trailing dot,
+// or nothing if deleted rows are included.
+func liveClause(alias string, includeDeleted bool) string {
+	if includeDeleted {
+		return ""
+	}
+
+	return " AND " + alias + "bitIsDeleted = 0"
+}
+
+// Helper to run fn with a service whose statements share one transaction, committed if fn returns true.
+// Within a batch the batch transaction is used, and committing is left to the batch.
+func (s *invService) inTransaction(fn func(txs *invService) bool) error {
+	if _, ok := s.db.(*sql.Tx); ok {
+		fn(s)
+		return nil
+	}
+
+	tx, err := s.pool.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	txs := &invService{logger: s.logger, db: tx, pool: s.pool, startSecs: s.startSecs,
+		idempotencyWindow: s.idempotencyWindow, purgeRetention: s.purgeRetention, jsonIndexDdl: s.jsonIndexDdl}
+	if !fn(txs) {
+		return nil
+	}
+
+	return tx.Commit()
+}
+
+// Helper to report an update or delete that changed no row, given the result of reading the record again. If the
+// record can still be read, the version given was stale and the result is 409 with the current version; otherwise
+// it is the error of the read, normally 404 not found.
+func versionConflict(readCode int32, readMessage string, currentVersion int32) (int32, string) {
+	if readCode != 0 {
+		return readCode, readMessage
+	}
+
+	return 409, fmt.Sprintf("version conflict, current version is %d", currentVersion)
+}
+
+// Helper to check if a database error is a unique key violation.
+func isDuplicateKey(err error) bool {
+	if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+		return mysqlErr.Number == 1062
+	}
+
+	return false
+}
+
+// Helper to describe a subarea name collision, naming the facility and parent subarea it conflicts under.
+func (s *invService) subareaConflictMessage(mserviceId int64, facilityId int64, parentSubareaId int64, name string) string {
+	where := fmt.Sprintf("top level of facility %d", facilityId)
+
+	if parentSubareaId != 0 {
+		where = fmt.Sprintf("parent subarea %d in facility %d", parentSubareaId, facilityId)
+
+		sqlstring := `SELECT chvSubareaName FROM tb_Subarea WHERE inbSubareaId = ? AND inbMserviceId = ?`
+
+		stmt, err := s.db.Prepare(sqlstring)
+		if err == nil {
+			defer stmt.Close()
+
+			var parentName string
+			err = stmt.QueryRow(parentSubareaId, mserviceId).Scan(&parentName)
+			if err == nil {
+				where = fmt.Sprintf("parent subarea %d (%s) in facility %d", parentSubareaId, parentName, facilityId)
+			}
+		}
+	}
+
+	return fmt.Sprintf("subarea_name '%s' already exists under %s", name, where)
+}
+
+// Helper to get the facility id for an existing subarea, zero if not found.
+func (s *invService) getSubareaFacilityId(mserviceId int64, subareaId int64) int64 {
+	var facilityId int64
+
+	sqlstring := `SELECT inbFacilityId FROM tb_Subarea WHERE inbSubareaId = ? AND inbMserviceId = ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		return 0
+	}
+
+	defer stmt.Close()
+
+	err = stmt.QueryRow(subareaId, mserviceId).Scan(&facilityId)
+	if err != nil && err != sql.ErrNoRows {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+	}
+
+	return facilityId
+}
+
+// Helper to get the position following the last live child of a parent subarea, or of the facility top level.
+// The excluded subarea, if any, is not counted, so a subarea being moved does not push its own position.
+// The children are locked, as ReorderSubareas does, so that two subareas added at once do not get the same position.
+func nextSubareaPosition(q dbQueryer, mserviceId int64, facilityId int64, parentSubareaId int64, excludeSubareaId int64) (int32, error) {
+	var position int32
+
+	sqlstring := `SELECT COALESCE(MAX(intPosition), 0) FROM tb_Subarea
+	WHERE inbMserviceId = ? AND inbFacilityId = ? AND inbParentSubareaId = ? AND inbSubareaId <> ? AND bitIsDeleted = 0
+	FOR UPDATE`
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	err = stmt.QueryRow(mserviceId, facilityId, parentSubareaId, excludeSubareaId).Scan(&position)
+	if err != nil {
+		return 0, err
+	}
+
+	return position + 1, nil
+}
+
 // Helper to convert Facility to FacilityWrapper.
 func convertFacilityToWrapper(facility *pb.Facility) *pb.FacilityWrapper {
 	wrap := pb.FacilityWrapper{}
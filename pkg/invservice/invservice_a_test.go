@@ -0,0 +1,90 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"testing"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestHasUpdateMask(t *testing.T) {
+	tests := []struct {
+		paths []string
+		want  bool
+	}{
+		{nil, false},
+		{[]string{}, false},
+		{[]string{"sku"}, true},
+		{[]string{"sku", "*"}, false},
+	}
+
+	for _, tt := range tests {
+		var mask *fieldmaskpb.FieldMask
+		if tt.paths != nil {
+			mask = &fieldmaskpb.FieldMask{Paths: tt.paths}
+		}
+
+		if got := hasUpdateMask(mask); got != tt.want {
+			t.Errorf("hasUpdateMask(%v) = %v, want %v", tt.paths, got, tt.want)
+		}
+	}
+}
+
+func TestApplyUpdateMask(t *testing.T) {
+	current := &pb.Product{ProductId: 9, Version: 4, MserviceId: 2, Sku: "OLD", ProductName: "Old name",
+		Comment: "old comment", JsonData: `{"a":1}`}
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  *pb.UpdateProductRequest
+		msg   string
+	}{
+		{"one field", []string{"sku"},
+			&pb.UpdateProductRequest{MserviceId: 1, ProductId: 8, Version: 3, Sku: "NEW", ProductName: "Old name",
+				Comment: "old comment", JsonData: `{"a":1}`}, ""},
+		{"cleared field", []string{"sku", "comment"},
+			&pb.UpdateProductRequest{MserviceId: 1, ProductId: 8, Version: 3, Sku: "NEW", ProductName: "Old name",
+				JsonData: `{"a":1}`}, ""},
+		{"unknown field", []string{"price"}, nil, "update_mask field 'price' not supported"},
+		{"key field", []string{"product_id"}, nil, "update_mask field 'product_id' not supported"},
+		{"version", []string{"version"}, nil, "update_mask field 'version' not supported"},
+		{"account", []string{"mservice_id"}, nil, "update_mask field 'mservice_id' not supported"},
+		{"nested path", []string{"sku.x"}, nil, "update_mask field 'sku.x' not supported"},
+		{"response only field", []string{"created"}, nil, "update_mask field 'created' not supported"},
+	}
+
+	for _, tt := range tests {
+		mask := &fieldmaskpb.FieldMask{Paths: tt.paths}
+		req := &pb.UpdateProductRequest{MserviceId: 1, ProductId: 8, Version: 3, Sku: "NEW", UpdateMask: mask}
+
+		msg := applyUpdateMask(mask, req, current, "product_id")
+		if msg != tt.msg {
+			t.Errorf("%s: message = %q, want %q", tt.name, msg, tt.msg)
+			continue
+		}
+
+		if msg != "" {
+			continue
+		}
+
+		req.UpdateMask = nil
+		if !proto.Equal(req, tt.want) {
+			t.Errorf("%s: request = %v, want %v", tt.name, req, tt.want)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"hash/fnv"
+	"strings"
+)
+
+// maximum number of results in a single page
+const maxPageSize = 1000
+
+// position in a paged list, carried between requests as an opaque page token
+type pageToken struct {
+	LastId int64    `json:"id"`
+	Keys   []string `json:"k,omitempty"`
+	Query  uint32   `json:"q,omitempty"`
+}
+
+// paging options for a list query
+type pageSpec struct {
+	size   int32
+	lastId int64
+	keys   []string
+	query  uint32
+}
+
+// Helper to validate the paging options of a list request.
+// A page size of zero returns all results, as before paging was added.
+// The query string is the normalized filter and order of the request, which must not change between pages,
+// and keyCount is the number of order_by terms whose last values the token carries.
+func parsePage(pageSize int32, token string, query string, keyCount int) (*pageSpec, string) {
+	page := &pageSpec{size: pageSize, query: queryHash(query)}
+
+	if pageSize < 0 {
+		return nil, "page_size must not be negative"
+	}
+
+	if pageSize > maxPageSize {
+		page.size = maxPageSize
+	}
+
+	if token == "" {
+		return page, ""
+	}
+
+	if pageSize == 0 {
+		return nil, "page_token requires page_size"
+	}
+
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, "page_token invalid"
+	}
+
+	var pt pageToken
+	err = json.Unmarshal(buf, &pt)
+	if (err != nil) || (pt.LastId <= 0) || (len(pt.Keys) != keyCount) {
+		return nil, "page_token invalid"
+	}
+
+	if pt.Query != page.query {
+		return nil, "page_token does not match filter and order_by"
+	}
+
+	page.lastId = pt.LastId
+	page.keys = pt.Keys
+
+	return page, ""
+}
+
+// Helper to hash the normalized filter and order of a list request, so a page token cannot be reused with another.
+func queryHash(query string) uint32 {
+	if query == "" {
+		return 0
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(query))
+
+	return h.Sum32()
+}
+
+// Helper to encode the page token for the page following the row with the given id and order_by values.
+func (p *pageSpec) nextToken(lastId int64, keys []string) string {
+	buf, _ := json.Marshal(pageToken{LastId: lastId, Keys: keys, Query: p.query})
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// true if only one page of results is wanted
+func (p *pageSpec) paged() bool {
+	return p.size > 0
+}
+
+// Helper to get the keyset condition, ordering and limit that select a page, with their arguments appended.
+// Results are ordered by the order_by terms, if any, then by id, so the order is stable across pages.
+// One row more than the page size is fetched, so the caller can tell whether another page follows.
+func (p *pageSpec) clause(idColumn string, order []orderTerm, args []interface{}) (string, []interface{}) {
+	sqlstring := ""
+
+	if p.paged() && (p.lastId != 0) {
+		// rows after the last one: (k1 > v1) OR (k1 = v1 AND k2 > v2) ... OR (k1 = v1 ... AND id > last id)
+		terms := make([]string, 0, len(order)+1)
+		for i := 0; i <= len(order); i++ {
+			conds := make([]string, 0, i+1)
+			for j := 0; j < i; j++ {
+				conds = append(conds, order[j].field.column+" = ?")
+				args = append(args, order[j].field.value(p.keys[j]))
+			}
+
+			if i < len(order) {
+				op := " > ?"
+				if order[i].desc {
+					op = " < ?"
+				}
+				conds = append(conds, order[i].field.column+op)
+				args = append(args, order[i].field.value(p.keys[i]))
+			} else {
+				conds = append(conds, idColumn+" > ?")
+				args = append(args, p.lastId)
+			}
+
+			terms = append(terms, "("+strings.Join(conds, " AND ")+")")
+		}
+
+		sqlstring = " AND (" + strings.Join(terms, " OR ") + ")"
+	}
+
+	if p.paged() || (len(order) > 0) {
+		columns := make([]string, 0, len(order)+1)
+		for _, term := range order {
+			if term.desc {
+				columns = append(columns, term.field.column+" DESC")
+			} else {
+				columns = append(columns, term.field.column)
+			}
+		}
+
+		columns = append(columns, idColumn)
+		sqlstring += " ORDER BY " + strings.Join(columns, ", ")
+	}
+
+	if p.paged() {
+		sqlstring += " LIMIT ?"
+		args = append(args, p.size+1)
+	}
+
+	return sqlstring, args
+}
+
+// true if the query returned more rows than fit in the page
+func (p *pageSpec) more(count int) bool {
+	return p.paged() && (count > int(p.size))
+}
+
+// Helper to count the rows matching the FROM and WHERE clauses of a list query.
+func (s *invService) countRows(fromWhere string, args []interface{}) (int64, error) {
+	var count int64
+
+	stmt, err := s.db.Prepare("SELECT COUNT(*) " + fromWhere)
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	err = stmt.QueryRow(args...).Scan(&count)
+
+	return count, err
+}
@@ -0,0 +1,110 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+)
+
+func TestParsePage(t *testing.T) {
+	query := "sku = \"a\"\nsku"
+	issued, _ := parsePage(10, "", query, 1)
+	token := issued.nextToken(42, []string{"W20"})
+
+	encode := func(json string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(json))
+	}
+
+	tests := []struct {
+		name     string
+		size     int32
+		token    string
+		query    string
+		keyCount int
+		msg      string
+	}{
+		{"first page", 10, "", query, 1, ""},
+		{"next page", 10, token, query, 1, ""},
+		{"negative size", -1, "", "", 0, "page_size must not be negative"},
+		{"token without size", 0, token, query, 1, "page_token requires page_size"},
+		{"not base64", 10, "!!!", query, 1, "page_token invalid"},
+		{"padded base64", 10, token + "=", query, 1, "page_token invalid"},
+		{"not json", 10, encode("id=42"), query, 1, "page_token invalid"},
+		{"zero id", 10, encode(`{"id":0,"k":["W20"],"q":1}`), query, 1, "page_token invalid"},
+		{"negative id", 10, encode(`{"id":-5,"k":["W20"]}`), "", 1, "page_token invalid"},
+		{"id not a number", 10, encode(`{"id":"42 OR 1=1","k":["W20"]}`), "", 1, "page_token invalid"},
+		{"missing keys", 10, encode(`{"id":42}`), "", 1, "page_token invalid"},
+		{"extra keys", 10, token, query, 0, "page_token invalid"},
+		{"other query", 10, token, "sku = \"b\"\nsku", 1, "page_token does not match filter and order_by"},
+		{"no query", 10, token, "", 1, "page_token does not match filter and order_by"},
+		{"forged query hash", 10, encode(`{"id":42,"k":["W20"],"q":7}`), query, 1,
+			"page_token does not match filter and order_by"},
+	}
+
+	for _, tt := range tests {
+		page, msg := parsePage(tt.size, tt.token, tt.query, tt.keyCount)
+		if msg != tt.msg {
+			t.Errorf("%s: message = %q, want %q", tt.name, msg, tt.msg)
+			continue
+		}
+
+		if (msg == "") && (tt.token != "") && ((page.lastId != 42) || !reflect.DeepEqual(page.keys, []string{"W20"})) {
+			t.Errorf("%s: page = %+v", tt.name, page)
+		}
+	}
+
+	if page, _ := parsePage(maxPageSize+1, "", "", 0); page.size != maxPageSize {
+		t.Errorf("page size %d not capped at %d", page.size, maxPageSize)
+	}
+}
+
+func TestPageClause(t *testing.T) {
+	order := []orderTerm{
+		{name: "sku", field: productFields["sku"]},
+		{name: "product_id", field: productFields["product_id"], desc: true},
+	}
+
+	page := &pageSpec{size: 5, lastId: 42, keys: []string{"W20", "7"}}
+	sql, args := page.clause("inbProductId", order, []interface{}{int64(1)})
+
+	wantSql := " AND ((chvSku > ?) OR (chvSku = ? AND inbProductId < ?) OR (chvSku = ? AND inbProductId = ? AND" +
+		" inbProductId > ?)) ORDER BY chvSku, inbProductId DESC, inbProductId LIMIT ?"
+	wantArgs := []interface{}{int64(1), "W20", "W20", int64(7), "W20", int64(7), int64(42), int32(6)}
+
+	if sql != wantSql {
+		t.Errorf("clause sql = %q, want %q", sql, wantSql)
+	}
+
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("clause args = %#v, want %#v", args, wantArgs)
+	}
+
+	// a tampered key is only ever a query argument, converted to the field's type
+	page = &pageSpec{size: 5, lastId: 42, keys: []string{"x' OR '1'='1", "1 OR 1=1"}}
+	sql, args = page.clause("inbProductId", order, nil)
+	if sql != wantSql {
+		t.Errorf("tampered clause sql = %q", sql)
+	}
+
+	if (args[0] != "x' OR '1'='1") || (args[2] != int64(0)) {
+		t.Errorf("tampered clause args = %#v", args)
+	}
+
+	unpaged := &pageSpec{}
+	if sql, args := unpaged.clause("inbProductId", nil, nil); (sql != "") || (args != nil) {
+		t.Errorf("unpaged clause = %q, %v", sql, args)
+	}
+}
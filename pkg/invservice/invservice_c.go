@@ -0,0 +1,714 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+// Filters follow a subset of AIP-160, for example
+//
+//	sku:"W20*" AND modified > "2026-01-01"
+//
+// Comparisons are joined with AND, OR and NOT and grouped with parentheses. Field names are looked up in a
+// per entity whitelist which supplies the SQL column, and every value is passed as a query argument.
+//
+// A field starting with $ is a json path into the entity's json_data extensions, for example
+//
+//	$.color = "red" AND $.voltage > 110
+//
+// A quoted value compares the field as a string, an unquoted number compares it as a number and only matches
+// numeric fields, and true or false match json booleans. If the path has been indexed with create_json_index,
+// the generated column holding its value is used instead of extracting it from every row, unless a string value
+// could match differently on the truncated text the column holds.
+
+// maximum length of a filter or order_by expression
+const maxFilterLength = 2000
+
+// maximum number of comparisons in a filter
+const maxFilterTerms = 50
+
+// maximum nesting of parentheses and NOT in a filter
+const maxFilterDepth = 10
+
+// kinds of filterable field
+const (
+	fieldInt = iota
+	fieldString
+	fieldTime
+	fieldJson
+)
+
+// layout of DATETIME values in the database
+const dateTimeLayout = "2006-01-02 15:04:05"
+
+// a field that can be used in a filter or order_by
+type listField struct {
+	column    string
+	kind      int
+	orderable bool
+}
+
+// json path of an extension field: member names and array indexes only, so it is safe to embed in generated column DDL
+var jsonPathPattern = regexp.MustCompile(`^\$(\.[A-Za-z_][A-Za-z0-9_]*|\[[0-9]+\])+$`)
+
+// json types compared as numbers
+const jsonNumberTypes = "'INTEGER', 'UNSIGNED INTEGER', 'DOUBLE', 'DECIMAL'"
+
+// one term of an order_by expression
+type orderTerm struct {
+	name  string
+	field listField
+	desc  bool
+}
+
+// filterable fields for products
+var productFields = map[string]listField{
+	"product_id":   {"inbProductId", fieldInt, true},
+	"sku":          {"chvSku", fieldString, true},
+	"product_name": {"chvProductName", fieldString, true},
+	"comment":      {"chvComment", fieldString, true},
+	"version":      {"intVersion", fieldInt, true},
+	"created":      {"dtmCreated", fieldTime, true},
+	"modified":     {"dtmModified", fieldTime, true},
+	"$":            {"chvJsonData", fieldJson, false},
+}
+
+// filterable fields for inventory items, where joined names can be filtered but not ordered on
+var inventoryItemFields = map[string]listField{
+	"inventory_item_id": {"i.inbInventoryItemId", fieldInt, true},
+	"subarea_id":        {"i.inbSubareaId", fieldInt, true},
+	"item_type_id":      {"i.intItemTypeId", fieldInt, true},
+	"quantity":          {"i.intQuantity", fieldInt, true},
+	"serial_number":     {"i.chvSerialNumber", fieldString, true},
+	"product_id":        {"i.inbProductId", fieldInt, true},
+	"version":           {"i.intVersion", fieldInt, true},
+	"created":           {"i.dtmCreated", fieldTime, true},
+	"modified":          {"i.dtmModified", fieldTime, true},
+	"item_type_name":    {"t.chvItemTypeName", fieldString, false},
+	"product_name":      {"p.chvProductName", fieldString, false},
+	"$":                 {"i.chvJsonData", fieldJson, false},
+}
+
+// Helper to convert a page token key back to a query argument of the field's type.
+func (f listField) value(key string) interface{} {
+	if f.kind == fieldInt {
+		n, _ := strconv.ParseInt(key, 10, 64)
+		return n
+	}
+
+	return key
+}
+
+// Helper to format a DATETIME value the way it is stored, for page token keys.
+func formatDateTime(dt *dml.DateTime) string {
+	return dt.TimeFromDateTime().Format(dateTimeLayout)
+}
+
+// Helper to get the page token keys of a product for the order_by terms.
+func productKeys(product *pb.Product, order []orderTerm) []string {
+	keys := make([]string, 0, len(order))
+	for _, term := range order {
+		var key string
+		switch term.name {
+		case "product_id":
+			key = strconv.FormatInt(product.GetProductId(), 10)
+		case "sku":
+			key = product.GetSku()
+		case "product_name":
+			key = product.GetProductName()
+		case "comment":
+			key = product.GetComment()
+		case "version":
+			key = strconv.Itoa(int(product.GetVersion()))
+		case "created":
+			key = formatDateTime(product.GetCreated())
+		case "modified":
+			key = formatDateTime(product.GetModified())
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// Helper to get the page token keys of an inventory item for the order_by terms.
+func inventoryItemKeys(item *pb.InventoryItem, order []orderTerm) []string {
+	keys := make([]string, 0, len(order))
+	for _, term := range order {
+		var key string
+		switch term.name {
+		case "inventory_item_id":
+			key = strconv.FormatInt(item.GetInventoryItemId(), 10)
+		case "subarea_id":
+			key = strconv.FormatInt(item.GetSubareaId(), 10)
+		case "item_type_id":
+			key = strconv.Itoa(int(item.GetItemTypeId()))
+		case "quantity":
+			key = strconv.Itoa(int(item.GetQuantity()))
+		case "serial_number":
+			key = item.GetSerialNumber()
+		case "product_id":
+			key = strconv.FormatInt(item.GetProductId(), 10)
+		case "version":
+			key = strconv.Itoa(int(item.GetVersion()))
+		case "created":
+			key = formatDateTime(item.GetCreated())
+		case "modified":
+			key = formatDateTime(item.GetModified())
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// Helper to parse an order_by expression such as "sku, modified desc" against a field whitelist.
+func parseOrderBy(orderBy string, fields map[string]listField) ([]orderTerm, string) {
+	order := make([]orderTerm, 0)
+
+	if strings.TrimSpace(orderBy) == "" {
+		return order, ""
+	}
+
+	if len(orderBy) > maxFilterLength {
+		return nil, "order_by too long"
+	}
+
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(orderBy, ",") {
+		words := strings.Fields(part)
+		if (len(words) == 0) || (len(words) > 2) {
+			return nil, fmt.Sprintf("order_by term '%s' invalid", strings.TrimSpace(part))
+		}
+
+		field, ok := fields[words[0]]
+		if !ok || !field.orderable {
+			return nil, fmt.Sprintf("order_by field '%s' not supported", words[0])
+		}
+
+		if seen[words[0]] {
+			return nil, fmt.Sprintf("order_by field '%s' given more than once", words[0])
+		}
+
+		seen[words[0]] = true
+
+		term := orderTerm{name: words[0], field: field}
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				term.desc = true
+			default:
+				return nil, fmt.Sprintf("order_by direction '%s' must be asc or desc", words[1])
+			}
+		}
+
+		order = append(order, term)
+	}
+
+	return order, ""
+}
+
+// Helper to get the normalized text of order_by terms, used to tie page tokens to their query.
+func orderByText(order []orderTerm) string {
+	parts := make([]string, 0, len(order))
+	for _, term := range order {
+		if term.desc {
+			parts = append(parts, term.name+" desc")
+		} else {
+			parts = append(parts, term.name)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// lexical token of a filter expression
+type filterToken struct {
+	text   string
+	quoted bool
+	pos    int
+}
+
+// state of a filter parse
+type filterParser struct {
+	tokens  []filterToken
+	next    int
+	fields  map[string]listField
+	indexes map[string]string
+	terms   int
+	args    []interface{}
+}
+
+// Helper to parse a filter expression against a field whitelist into a SQL condition and its arguments.
+// The indexes map json paths and value types, as keyed by jsonIndexKey, to generated columns.
+// The condition is empty if the filter is empty.
+func parseFilter(filter string, fields map[string]listField, indexes map[string]string) (string, []interface{}, string) {
+	if strings.TrimSpace(filter) == "" {
+		return "", nil, ""
+	}
+
+	if len(filter) > maxFilterLength {
+		return "", nil, "filter too long"
+	}
+
+	tokens, msg := lexFilter(filter)
+	if msg != "" {
+		return "", nil, msg
+	}
+
+	p := &filterParser{tokens: tokens, fields: fields, indexes: indexes}
+
+	sqlstring, msg := p.parseOr(0)
+	if msg != "" {
+		return "", nil, msg
+	}
+
+	if p.next < len(p.tokens) {
+		return "", nil, fmt.Sprintf("filter: unexpected '%s' at %d", p.tokens[p.next].text, p.tokens[p.next].pos)
+	}
+
+	return sqlstring, p.args, ""
+}
+
+// Helper to split a filter into tokens: parentheses, comparison operators, quoted strings and words.
+func lexFilter(filter string) ([]filterToken, string) {
+	tokens := make([]filterToken, 0)
+	runes := []rune(filter)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case (r == '(') || (r == ')') || (r == ':') || (r == '='):
+			tokens = append(tokens, filterToken{text: string(r), pos: i + 1})
+			i++
+
+		case (r == '<') || (r == '>') || (r == '!'):
+			if (i+1 < len(runes)) && (runes[i+1] == '=') {
+				tokens = append(tokens, filterToken{text: string(runes[i : i+2]), pos: i + 1})
+				i += 2
+			} else if r == '!' {
+				return nil, fmt.Sprintf("filter: unexpected '!' at %d", i+1)
+			} else {
+				tokens = append(tokens, filterToken{text: string(r), pos: i + 1})
+				i++
+			}
+
+		case r == '"':
+			var sb strings.Builder
+			start := i
+			i++
+			for ; (i < len(runes)) && (runes[i] != '"'); i++ {
+				if (runes[i] == '\\') && (i+1 < len(runes)) {
+					i++
+				}
+				sb.WriteRune(runes[i])
+			}
+
+			if i >= len(runes) {
+				return nil, fmt.Sprintf("filter: unterminated string at %d", start+1)
+			}
+
+			tokens = append(tokens, filterToken{text: sb.String(), quoted: true, pos: start + 1})
+			i++
+
+		default:
+			start := i
+			for ; (i < len(runes)) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("()<>=!:\"", runes[i]); i++ {
+			}
+			tokens = append(tokens, filterToken{text: string(runes[start:i]), pos: start + 1})
+		}
+	}
+
+	return tokens, ""
+}
+
+// Helper to peek at the next unquoted keyword or symbol, empty at the end of the filter.
+func (p *filterParser) peek() string {
+	if (p.next >= len(p.tokens)) || p.tokens[p.next].quoted {
+		return ""
+	}
+
+	return p.tokens[p.next].text
+}
+
+// Helper to describe where the parse is, for error messages.
+func (p *filterParser) where() string {
+	if p.next >= len(p.tokens) {
+		return "at end of filter"
+	}
+
+	return fmt.Sprintf("at %d", p.tokens[p.next].pos)
+}
+
+// or_expr := and_expr { OR and_expr }
+func (p *filterParser) parseOr(depth int) (string, string) {
+	left, msg := p.parseAnd(depth)
+	if msg != "" {
+		return "", msg
+	}
+
+	for p.peek() == "OR" {
+		p.next++
+		right, msg := p.parseAnd(depth)
+		if msg != "" {
+			return "", msg
+		}
+
+		left = left + " OR " + right
+	}
+
+	return left, ""
+}
+
+// and_expr := unary { [AND] unary }
+func (p *filterParser) parseAnd(depth int) (string, string) {
+	left, msg := p.parseUnary(depth)
+	if msg != "" {
+		return "", msg
+	}
+
+	for {
+		tok := p.peek()
+		if tok == "AND" {
+			p.next++
+		} else if (p.next >= len(p.tokens)) || (tok == "OR") || (tok == ")") {
+			break
+		}
+
+		right, msg := p.parseUnary(depth)
+		if msg != "" {
+			return "", msg
+		}
+
+		left = left + " AND " + right
+	}
+
+	return left, ""
+}
+
+// unary := NOT unary | "(" or_expr ")" | comparison
+func (p *filterParser) parseUnary(depth int) (string, string) {
+	if depth > maxFilterDepth {
+		return "", "filter nested too deeply"
+	}
+
+	switch p.peek() {
+	case "NOT":
+		p.next++
+		inner, msg := p.parseUnary(depth + 1)
+		if msg != "" {
+			return "", msg
+		}
+
+		return "NOT (" + inner + ")", ""
+
+	case "(":
+		p.next++
+		inner, msg := p.parseOr(depth + 1)
+		if msg != "" {
+			return "", msg
+		}
+
+		if p.peek() != ")" {
+			return "", "filter: expected ')' " + p.where()
+		}
+
+		p.next++
+
+		return "(" + inner + ")", ""
+	}
+
+	return p.parseComparison()
+}
+
+// comparison := field op value
+func (p *filterParser) parseComparison() (string, string) {
+	if (p.next >= len(p.tokens)) || p.tokens[p.next].quoted {
+		return "", "filter: expected field name " + p.where()
+	}
+
+	name := p.tokens[p.next].text
+	key := name
+	if strings.HasPrefix(name, "$") {
+		key = "$"
+	}
+
+	field, ok := p.fields[key]
+	if !ok {
+		return "", fmt.Sprintf("filter field '%s' not supported", name)
+	}
+
+	if (field.kind == fieldJson) && !jsonPathPattern.MatchString(name) {
+		return "", fmt.Sprintf("filter json path '%s' invalid, use member names and array indexes such as $.a.b[0]", name)
+	}
+
+	p.next++
+
+	op := p.peek()
+	switch op {
+	case "=", "!=", "<", "<=", ">", ">=", ":":
+		p.next++
+	default:
+		return "", fmt.Sprintf("filter: expected comparison after '%s' %s", name, p.where())
+	}
+
+	if p.next >= len(p.tokens) {
+		return "", fmt.Sprintf("filter: expected value for '%s' at end of filter", name)
+	}
+
+	value := p.tokens[p.next]
+	if !value.quoted && strings.ContainsAny(value.text, "()") {
+		return "", fmt.Sprintf("filter: expected value for '%s' %s", name, p.where())
+	}
+
+	p.next++
+
+	p.terms++
+	if p.terms > maxFilterTerms {
+		return "", fmt.Sprintf("filter has more than %d comparisons", maxFilterTerms)
+	}
+
+	if op == ":" {
+		op = "="
+	}
+
+	if field.kind == fieldJson {
+		return p.jsonComparison(name, field.column, op, value)
+	}
+
+	switch field.kind {
+	case fieldInt:
+		n, err := strconv.ParseInt(value.text, 10, 64)
+		if err != nil {
+			return "", fmt.Sprintf("filter field '%s' needs an integer, not '%s'", name, value.text)
+		}
+
+		p.args = append(p.args, n)
+
+	case fieldTime:
+		t, ok := parseFilterTime(value.text)
+		if !ok {
+			return "", fmt.Sprintf("filter field '%s' needs a date as YYYY-MM-DD or YYYY-MM-DDTHH:MM:SS, not '%s'",
+				name, value.text)
+		}
+
+		p.args = append(p.args, t.Format(dateTimeLayout))
+
+	case fieldString:
+		return p.stringComparison(name, field.column, op, value.text)
+	}
+
+	return sqlComparison(field.column, op), ""
+}
+
+// Helper to compare a string expression, where a value with * wildcards becomes a LIKE pattern.
+func (p *filterParser) stringComparison(name string, expr string, op string, text string) (string, string) {
+	if strings.Contains(text, "*") {
+		if (op != "=") && (op != "!=") {
+			return "", fmt.Sprintf("filter: wildcard on '%s' needs ':', '=' or '!='", name)
+		}
+
+		p.args = append(p.args, likePattern(text))
+		if op == "=" {
+			return expr + " LIKE ?", ""
+		}
+
+		return expr + " NOT LIKE ?", ""
+	}
+
+	p.args = append(p.args, text)
+
+	return sqlComparison(expr, op), ""
+}
+
+// Helper to compare an expression with the next query argument.
+func sqlComparison(expr string, op string) string {
+	if op == "!=" {
+		op = "<>"
+	}
+
+	return expr + " " + op + " ?"
+}
+
+// Helper to compare a json_data extension field, using its generated column if the path is indexed.
+func (p *filterParser) jsonComparison(path string, column string, op string, value filterToken) (string, string) {
+	if !value.quoted && ((value.text == "true") || (value.text == "false")) {
+		if (op != "=") && (op != "!=") {
+			return "", fmt.Sprintf("filter: boolean value for '%s' needs ':', '=' or '!='", path)
+		}
+
+		p.args = append(p.args, path, path, value.text)
+
+		return sqlComparison(jsonBoolean(column), op), ""
+	}
+
+	if !value.quoted {
+		n, err := strconv.ParseFloat(value.text, 64)
+		if err == nil {
+			expr := jsonNumber(column)
+			if generated, ok := p.indexes[jsonIndexKey(path, jsonIndexNumber)]; ok {
+				expr = qualifyColumn(column, generated)
+			} else {
+				p.args = append(p.args, path, path)
+			}
+
+			p.args = append(p.args, n)
+
+			return sqlComparison(expr, op), ""
+		}
+	}
+
+	expr := jsonString(column)
+	if generated, ok := p.indexes[jsonIndexKey(path, jsonIndexString)]; ok && fitsStringIndex(value.text) {
+		expr = qualifyColumn(column, generated)
+	} else {
+		p.args = append(p.args, path)
+	}
+
+	return p.stringComparison(path, expr, op, value.text)
+}
+
+// Helper to tell whether a string comparison gives the same rows on a generated column, which holds only the first
+// jsonIndexStringLength characters of each value. A value shorter than that, with at most a trailing wildcard, is
+// decided within those characters, so comparing the prefix is the same as comparing the whole value.
+func fitsStringIndex(text string) bool {
+	if star := strings.Index(text, "*"); (star >= 0) && (star != len(text)-1) {
+		return false
+	}
+
+	return utf8.RuneCountInString(text) < jsonIndexStringLength
+}
+
+// Helper to qualify a generated column with the table alias of the json_data column it is generated from.
+func qualifyColumn(jsonColumn string, column string) string {
+	if i := strings.LastIndex(jsonColumn, "."); i >= 0 {
+		return jsonColumn[:i+1] + column
+	}
+
+	return column
+}
+
+// Helper to get the SQL extracting the json value at the path given as a query argument, NULL if json_data is not json.
+func jsonExtract(column string) string {
+	return "JSON_EXTRACT(IF(JSON_VALID(" + column + "), " + column + ", NULL), ?)"
+}
+
+// Helper to get the SQL for the unquoted text of the json value at the path given as a query argument.
+func jsonString(column string) string {
+	return "JSON_UNQUOTE(" + jsonExtract(column) + ")"
+}
+
+// Helper to get the SQL for the json value at the path given as a query argument, twice, NULL unless it is a number.
+func jsonNumber(column string) string {
+	extract := jsonExtract(column)
+	return "IF(JSON_TYPE(" + extract + ") IN (" + jsonNumberTypes + "), " + extract + ", NULL)"
+}
+
+// Helper to get the SQL for the text true or false of the json value at the path given as a query argument, twice,
+// NULL unless it is a boolean.
+func jsonBoolean(column string) string {
+	extract := jsonExtract(column)
+	return "IF(JSON_TYPE(" + extract + ") = 'BOOLEAN', JSON_UNQUOTE(" + extract + "), NULL)"
+}
+
+// Helper to parse a date or date and time in a filter, in local time like the stored values.
+func parseFilterTime(text string) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02", "2006-01-02T15:04:05", dateTimeLayout} {
+		t, err := time.ParseInLocation(layout, text, time.Local)
+		if err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// Helper to convert a filter value with * wildcards into a LIKE pattern, escaping LIKE's own wildcards.
+func likePattern(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		switch r {
+		case '%', '_', '\\':
+			sb.WriteRune('\\')
+			sb.WriteRune(r)
+		case '*':
+			sb.WriteRune('%')
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
+
+// filter, order and paging options of a list request
+type listOptions struct {
+	page  *pageSpec
+	order []orderTerm
+	where string
+	args  []interface{}
+}
+
+// Helper to parse the filter, order_by and paging options of a list request against a field whitelist,
+// using the generated columns of any indexed json paths.
+func parseListOptions(filter string, orderBy string, pageSize int32, pageToken string,
+	fields map[string]listField, indexes map[string]string) (*listOptions, string) {
+
+	opts := &listOptions{}
+	var msg string
+
+	opts.order, msg = parseOrderBy(orderBy, fields)
+	if msg != "" {
+		return nil, msg
+	}
+
+	opts.where, opts.args, msg = parseFilter(filter, fields, indexes)
+	if msg != "" {
+		return nil, msg
+	}
+
+	if opts.where != "" {
+		opts.where = " AND (" + opts.where + ")"
+	}
+
+	query := ""
+	if (strings.TrimSpace(filter) != "") || (len(opts.order) > 0) {
+		query = strings.TrimSpace(filter) + "\n" + orderByText(opts.order)
+	}
+
+	opts.page, msg = parsePage(pageSize, pageToken, query, len(opts.order))
+	if msg != "" {
+		return nil, msg
+	}
+
+	return opts, ""
+}
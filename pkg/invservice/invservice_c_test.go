@@ -0,0 +1,264 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	productJson := jsonString("chvJsonData")
+	itemJson := jsonString("i.chvJsonData")
+	colorColumn := jsonIndexColumnName("$.color", jsonIndexString)
+	voltageColumn := jsonIndexColumnName("$.voltage", jsonIndexNumber)
+	indexes := map[string]string{
+		jsonIndexKey("$.color", jsonIndexString):   colorColumn,
+		jsonIndexKey("$.voltage", jsonIndexNumber): voltageColumn,
+	}
+
+	long := strings.Repeat("x", jsonIndexStringLength)
+
+	tests := []struct {
+		name   string
+		filter string
+		fields map[string]listField
+		sql    string
+		args   []interface{}
+	}{
+		{"empty", "  ", productFields, "", nil},
+		{"string", `sku = "W20"`, productFields, "chvSku = ?", []interface{}{"W20"}},
+		{"has is equals", `sku:"W20"`, productFields, "chvSku = ?", []interface{}{"W20"}},
+		{"not equal", `sku != "W20"`, productFields, "chvSku <> ?", []interface{}{"W20"}},
+		{"quote in value", `product_name = "x' OR 1=1 --"`, productFields, "chvProductName = ?",
+			[]interface{}{"x' OR 1=1 --"}},
+		{"escaped quote", `product_name = "a\"b"`, productFields, "chvProductName = ?", []interface{}{`a"b`}},
+		{"escaped backslash", `product_name = "a\\"`, productFields, "chvProductName = ?", []interface{}{`a\`}},
+		{"wildcard", `sku:"W2*"`, productFields, "chvSku LIKE ?", []interface{}{"W2%"}},
+		{"like characters escaped", `sku = "50%_a\\b*"`, productFields, "chvSku LIKE ?",
+			[]interface{}{`50\%\_a\\b%`}},
+		{"not like", `sku != "*x"`, productFields, "chvSku NOT LIKE ?", []interface{}{"%x"}},
+		{"integer", "product_id >= 7", productFields, "inbProductId >= ?", []interface{}{int64(7)}},
+		{"date", `modified > "2026-01-02"`, productFields, "dtmModified > ?", []interface{}{"2026-01-02 00:00:00"}},
+		{"implicit and", `sku:"a" product_id = 2`, productFields, "chvSku = ? AND inbProductId = ?",
+			[]interface{}{"a", int64(2)}},
+		{"precedence", `sku = "a" OR sku = "b" AND NOT (product_id = 1 OR product_id = 2)`, productFields,
+			"chvSku = ? OR chvSku = ? AND NOT ((inbProductId = ? OR inbProductId = ?))",
+			[]interface{}{"a", "b", int64(1), int64(2)}},
+		{"json string", `$.shade = "red"`, productFields, productJson + " = ?", []interface{}{"$.shade", "red"}},
+		{"json string indexed", `$.color = "red"`, productFields, colorColumn + " = ?", []interface{}{"red"}},
+		{"json prefix indexed", `$.color = "re*"`, productFields, colorColumn + " LIKE ?", []interface{}{"re%"}},
+		{"json inner wildcard not indexed", `$.color = "r*d"`, productFields, productJson + " LIKE ?",
+			[]interface{}{"$.color", "r%d"}},
+		{"json long value not indexed", `$.color < "` + long + `"`, productFields, productJson + " < ?",
+			[]interface{}{"$.color", long}},
+		{"json short value indexed", `$.color < "` + long[1:] + `"`, productFields, colorColumn + " < ?",
+			[]interface{}{long[1:]}},
+		{"json number", "$.size > 1.5", productFields, jsonNumber("chvJsonData") + " > ?",
+			[]interface{}{"$.size", "$.size", 1.5}},
+		{"json number indexed", "$.voltage > 110", productFields, voltageColumn + " > ?", []interface{}{110.0}},
+		{"json number indexed item", "$.voltage > 110", inventoryItemFields, "i." + voltageColumn + " > ?",
+			[]interface{}{110.0}},
+		{"json quoted number is text", `$.size = "2"`, inventoryItemFields, itemJson + " = ?",
+			[]interface{}{"$.size", "2"}},
+		{"json boolean", "$.fragile = true", productFields, jsonBoolean("chvJsonData") + " = ?",
+			[]interface{}{"$.fragile", "$.fragile", "true"}},
+		{"json array index", `$.dims[0].w = "1"`, productFields, productJson + " = ?",
+			[]interface{}{"$.dims[0].w", "1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, msg := parseFilter(tt.filter, tt.fields, indexes)
+			if msg != "" {
+				t.Fatalf("parseFilter(%q) failed: %s", tt.filter, msg)
+			}
+
+			if sql != tt.sql {
+				t.Errorf("parseFilter(%q) sql = %q, want %q", tt.filter, sql, tt.sql)
+			}
+
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("parseFilter(%q) args = %#v, want %#v", tt.filter, args, tt.args)
+			}
+
+			if strings.Count(sql, "?") != len(args) {
+				t.Errorf("parseFilter(%q) has %d placeholders for %d args", tt.filter, strings.Count(sql, "?"), len(args))
+			}
+		})
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	terms := func(n int) string {
+		parts := make([]string, n)
+		for i := range parts {
+			parts[i] = "product_id = 1"
+		}
+
+		return strings.Join(parts, " OR ")
+	}
+
+	tests := []struct {
+		name   string
+		filter string
+		msg    string
+	}{
+		{"too long", `sku = "` + strings.Repeat("a", maxFilterLength) + `"`, "filter too long"},
+		{"unknown field", `price = 1`, "filter field 'price' not supported"},
+		{"sql in field name", `sku;DROP = 1`, "filter field 'sku;DROP' not supported"},
+		{"sql in json path", `$.a') OR 1=1 -- = "x"`, "filter json path '$.a'' invalid"},
+		{"json path quote", `$.a"b" = 1`, "filter: expected comparison after '$.a' at 4"},
+		{"json path wildcard", `$.* = 1`, "filter json path '$.*' invalid"},
+		{"quoted field", `"sku" = "a"`, "filter: expected field name at 1"},
+		{"unterminated", `sku = "abc`, "filter: unterminated string at 7"},
+		{"bang", `sku ! "a"`, "filter: unexpected '!' at 5"},
+		{"no operator", `sku "a"`, "filter: expected comparison after 'sku' at 5"},
+		{"no value", `sku =`, "filter: expected value for 'sku' at end of filter"},
+		{"parenthesis value", `sku = (`, "filter: expected value for 'sku' at 7"},
+		{"unclosed", `(sku = "a"`, "filter: expected ')' at end of filter"},
+		{"trailing", `sku = "a")`, "filter: unexpected ')' at 10"},
+		{"integer", `product_id = "1; DROP"`, "filter field 'product_id' needs an integer"},
+		{"date", `created > "yesterday"`, "filter field 'created' needs a date"},
+		{"wildcard order", `sku > "a*"`, "filter: wildcard on 'sku' needs ':', '=' or '!='"},
+		{"boolean order", `$.a < true`, "filter: boolean value for '$.a' needs ':', '=' or '!='"},
+		{"too deep", strings.Repeat("(", maxFilterDepth+1) + "product_id = 1" + strings.Repeat(")", maxFilterDepth+1),
+			"filter nested too deeply"},
+		{"too many nots", strings.Repeat("NOT ", maxFilterDepth+1) + "product_id = 1", "filter nested too deeply"},
+		{"too many terms", terms(maxFilterTerms + 1), "filter has more than 50 comparisons"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, msg := parseFilter(tt.filter, productFields, nil)
+			if !strings.HasPrefix(msg, tt.msg) {
+				t.Errorf("parseFilter(%q) message = %q, want prefix %q", tt.filter, msg, tt.msg)
+			}
+
+			if (sql != "") || (args != nil) {
+				t.Errorf("parseFilter(%q) = %q, %v on error", tt.filter, sql, args)
+			}
+		})
+	}
+}
+
+func TestParseFilterLimits(t *testing.T) {
+	deep := strings.Repeat("(", maxFilterDepth) + "product_id = 1" + strings.Repeat(")", maxFilterDepth)
+	if _, _, msg := parseFilter(deep, productFields, nil); msg != "" {
+		t.Errorf("filter nested %d deep failed: %s", maxFilterDepth, msg)
+	}
+
+	parts := make([]string, maxFilterTerms)
+	for i := range parts {
+		parts[i] = "product_id = 1"
+	}
+
+	_, args, msg := parseFilter(strings.Join(parts, " AND "), productFields, nil)
+	if (msg != "") || (len(args) != maxFilterTerms) {
+		t.Errorf("filter of %d comparisons = %d args, %q", maxFilterTerms, len(args), msg)
+	}
+}
+
+func TestParseOrderBy(t *testing.T) {
+	tests := []struct {
+		orderBy string
+		text    string
+		msg     string
+	}{
+		{"", "", ""},
+		{"sku, modified DESC", "sku, modified desc", ""},
+		{"product_id asc", "product_id", ""},
+		{"sku desc, sku", "", "order_by field 'sku' given more than once"},
+		{"sku; DROP TABLE tb_Product", "", "order_by term 'sku; DROP TABLE tb_Product' invalid"},
+		{"sku sideways", "", "order_by direction 'sideways' must be asc or desc"},
+		{"chvSku", "", "order_by field 'chvSku' not supported"},
+		{"$.color", "", "order_by field '$.color' not supported"},
+		{"sku,", "", "order_by term '' invalid"},
+	}
+
+	for _, tt := range tests {
+		order, msg := parseOrderBy(tt.orderBy, productFields)
+		if msg != tt.msg {
+			t.Errorf("parseOrderBy(%q) message = %q, want %q", tt.orderBy, msg, tt.msg)
+			continue
+		}
+
+		if (msg == "") && (orderByText(order) != tt.text) {
+			t.Errorf("parseOrderBy(%q) = %q, want %q", tt.orderBy, orderByText(order), tt.text)
+		}
+	}
+
+	if _, msg := parseOrderBy("product_name", inventoryItemFields); msg != "order_by field 'product_name' not supported" {
+		t.Errorf("joined product_name orderable: %q", msg)
+	}
+}
+
+func TestParseListOptions(t *testing.T) {
+	opts, msg := parseListOptions(`sku = "a"`, "sku desc", 10, "", productFields, nil)
+	if msg != "" {
+		t.Fatalf("parseListOptions failed: %s", msg)
+	}
+
+	if opts.where != " AND (chvSku = ?)" {
+		t.Errorf("where = %q", opts.where)
+	}
+
+	token := opts.page.nextToken(42, []string{"m"})
+
+	tests := []struct {
+		name    string
+		filter  string
+		orderBy string
+		msg     string
+	}{
+		{"same query", `sku = "a"`, "sku desc", ""},
+		{"same query reformatted", ` sku = "a" `, "sku   DESC", ""},
+		{"other filter", `sku = "b"`, "sku desc", "page_token does not match filter and order_by"},
+		{"other order", `sku = "a"`, "sku", "page_token does not match filter and order_by"},
+		{"no filter", "", "sku desc", "page_token does not match filter and order_by"},
+		{"other key count", `sku = "a"`, "sku desc, modified", "page_token invalid"},
+		{"bad filter", `sku = `, "", "filter: expected value for 'sku' at end of filter"},
+		{"bad order", `sku = "a"`, "price", "order_by field 'price' not supported"},
+	}
+
+	for _, tt := range tests {
+		opts, msg := parseListOptions(tt.filter, tt.orderBy, 10, token, productFields, nil)
+		if msg != tt.msg {
+			t.Errorf("%s: message = %q, want %q", tt.name, msg, tt.msg)
+			continue
+		}
+
+		if (msg == "") && ((opts.page.lastId != 42) || !reflect.DeepEqual(opts.page.keys, []string{"m"})) {
+			t.Errorf("%s: page = %+v", tt.name, opts.page)
+		}
+	}
+}
+
+func TestLikePattern(t *testing.T) {
+	tests := map[string]string{
+		"abc":     "abc",
+		"a*":      "a%",
+		"*a*b*":   "%a%b%",
+		"100%":    `100\%`,
+		"a_b":     `a\_b`,
+		`c:\dir*`: `c:\\dir%`,
+	}
+
+	for text, want := range tests {
+		if got := likePattern(text); got != want {
+			t.Errorf("likePattern(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/log/level"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+// Deletes only mark a row deleted, so an undelete clears the mark again and bumps the version. The unique keys on
+// names cover deleted rows too, so a deleted record keeps its name and no live record can take it; the keys are the
+// only check, and a restore they refuse reports the name as in use.
+
+// restore a deleted facility
+func (s *invService) UndeleteFacility(ctx context.Context, req *pb.UndeleteFacilityRequest) (*pb.UndeleteFacilityResponse, error) {
+	resp := &pb.UndeleteFacilityResponse{}
+
+	err := s.inTransaction(func(txs *invService) bool {
+		gResp, facility := txs.GetFacilityHelper(req.GetMserviceId(), req.GetFacilityId(), true)
+		if gResp.ErrorCode == 0 && !facility.GetIsDeleted() {
+			gResp.ErrorCode = 510
+			gResp.ErrorMessage = "facility is not deleted"
+			gResp.ErrorReason = reasonNotDeleted
+		}
+
+		if gResp.ErrorCode == 0 {
+			gResp = txs.undeleteRow("tb_Facility", "inbFacilityId", req.GetFacilityId(), req.GetMserviceId(), req.GetVersion(),
+				"facility", facility.GetFacilityName())
+		}
+
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		resp.ErrorReason = gResp.ErrorReason
+		if resp.ErrorCode == 0 {
+			resp.Version = req.GetVersion() + 1
+		}
+
+		return resp.ErrorCode == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.UndeleteFacilityResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// restore a deleted subarea
+func (s *invService) UndeleteSubarea(ctx context.Context, req *pb.UndeleteSubareaRequest) (*pb.UndeleteSubareaResponse, error) {
+	resp := &pb.UndeleteSubareaResponse{}
+
+	err := s.inTransaction(func(txs *invService) bool {
+		current, _ := txs.GetSubarea(ctx, &pb.GetSubareaRequest{MserviceId: req.GetMserviceId(), SubareaId: req.GetSubareaId(),
+			IncludeDeleted: true})
+		gResp := &genericResponse{ErrorCode: current.GetErrorCode(), ErrorMessage: current.GetErrorMessage()}
+		subarea := current.GetSubarea()
+
+		if gResp.ErrorCode == 0 && !subarea.GetIsDeleted() {
+			gResp.ErrorCode = 510
+			gResp.ErrorMessage = "subarea is not deleted"
+			gResp.ErrorReason = reasonNotDeleted
+		}
+
+		if gResp.ErrorCode == 0 {
+			gResp, _ = txs.GetFacilityHelper(req.GetMserviceId(), subarea.GetFacilityId(), false)
+			if gResp.ErrorCode == 404 {
+				gResp.ErrorCode = 510
+				gResp.ErrorMessage = fmt.Sprintf("facility %d is deleted, undelete it first", subarea.GetFacilityId())
+				gResp.ErrorReason = reasonParentDeleted
+			}
+		}
+
+		if gResp.ErrorCode == 0 && subarea.GetParentSubareaId() != 0 {
+			parent, _ := txs.GetSubarea(ctx, &pb.GetSubareaRequest{MserviceId: req.GetMserviceId(),
+				SubareaId: subarea.GetParentSubareaId()})
+			gResp = &genericResponse{ErrorCode: parent.GetErrorCode(), ErrorMessage: parent.GetErrorMessage()}
+			if gResp.ErrorCode == 404 {
+				gResp.ErrorCode = 510
+				gResp.ErrorMessage = fmt.Sprintf("parent subarea %d is deleted, undelete it first", subarea.GetParentSubareaId())
+				gResp.ErrorReason = reasonParentDeleted
+			}
+		}
+
+		if gResp.ErrorCode == 0 {
+			msg, err := txs.checkSubareaReferences(txs.db, req.GetMserviceId(), req.GetSubareaId(), subarea.GetFacilityId(),
+				subarea.GetParentSubareaId(), subarea.GetSubareaTypeId())
+			if err != nil {
+				level.Error(s.logger).Log("what", "checkSubareaReferences", "error", err)
+				gResp = &genericResponse{ErrorCode: 500, ErrorMessage: err.Error()}
+			} else if msg != "" {
+				gResp = &genericResponse{ErrorCode: 510, ErrorMessage: msg}
+			}
+		}
+
+		if gResp.ErrorCode == 0 {
+			gResp = txs.undeleteRow("tb_Subarea", "inbSubareaId", req.GetSubareaId(), req.GetMserviceId(), req.GetVersion(),
+				"subarea", subarea.GetSubareaName())
+		}
+
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		resp.ErrorReason = gResp.ErrorReason
+		if resp.ErrorCode == 0 {
+			resp.Version = req.GetVersion() + 1
+		}
+
+		return resp.ErrorCode == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.UndeleteSubareaResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// restore a deleted product
+func (s *invService) UndeleteProduct(ctx context.Context, req *pb.UndeleteProductRequest) (*pb.UndeleteProductResponse, error) {
+	resp := &pb.UndeleteProductResponse{}
+
+	err := s.inTransaction(func(txs *invService) bool {
+		current, _ := txs.GetProduct(ctx, &pb.GetProductRequest{MserviceId: req.GetMserviceId(), ProductId: req.GetProductId(),
+			IncludeDeleted: true})
+		gResp := &genericResponse{ErrorCode: current.GetErrorCode(), ErrorMessage: current.GetErrorMessage()}
+		product := current.GetProduct()
+
+		if gResp.ErrorCode == 0 && !product.GetIsDeleted() {
+			gResp.ErrorCode = 510
+			gResp.ErrorMessage = "product is not deleted"
+			gResp.ErrorReason = reasonNotDeleted
+		}
+
+		if gResp.ErrorCode == 0 {
+			gResp = txs.undeleteRow("tb_Product", "inbProductId", req.GetProductId(), req.GetMserviceId(), req.GetVersion(),
+				"product", product.GetProductName())
+		}
+
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		resp.ErrorReason = gResp.ErrorReason
+		if resp.ErrorCode == 0 {
+			resp.Version = req.GetVersion() + 1
+		}
+
+		return resp.ErrorCode == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.UndeleteProductResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// restore a deleted inventory item
+func (s *invService) UndeleteInventoryItem(ctx context.Context, req *pb.UndeleteInventoryItemRequest) (*pb.UndeleteInventoryItemResponse, error) {
+	resp := &pb.UndeleteInventoryItemResponse{}
+
+	err := s.inTransaction(func(txs *invService) bool {
+		current, _ := txs.GetInventoryItem(ctx, &pb.GetInventoryItemRequest{MserviceId: req.GetMserviceId(),
+			InventoryItemId: req.GetInventoryItemId(), IncludeDeleted: true})
+		gResp := &genericResponse{ErrorCode: current.GetErrorCode(), ErrorMessage: current.GetErrorMessage()}
+		item := current.GetInventoryItem()
+
+		if gResp.ErrorCode == 0 && !item.GetIsDeleted() {
+			gResp.ErrorCode = 510
+			gResp.ErrorMessage = "inventory item is not deleted"
+			gResp.ErrorReason = reasonNotDeleted
+		}
+
+		if gResp.ErrorCode == 0 {
+			subarea, _ := txs.GetSubarea(ctx, &pb.GetSubareaRequest{MserviceId: req.GetMserviceId(), SubareaId: item.GetSubareaId()})
+			gResp = &genericResponse{ErrorCode: subarea.GetErrorCode(), ErrorMessage: subarea.GetErrorMessage()}
+			if gResp.ErrorCode == 404 {
+				gResp.ErrorCode = 510
+				gResp.ErrorMessage = fmt.Sprintf("subarea %d is deleted, undelete it first", item.GetSubareaId())
+				gResp.ErrorReason = reasonParentDeleted
+			}
+		}
+
+		if gResp.ErrorCode == 0 {
+			msg, err := txs.checkItemReferences(txs.db, req.GetMserviceId(), item.GetSubareaId(), item.GetItemTypeId(),
+				item.GetProductId())
+			if err != nil {
+				level.Error(s.logger).Log("what", "checkItemReferences", "error", err)
+				gResp = &genericResponse{ErrorCode: 500, ErrorMessage: err.Error()}
+			} else if msg != "" {
+				gResp = &genericResponse{ErrorCode: 510, ErrorMessage: msg}
+			}
+		}
+
+		if gResp.ErrorCode == 0 {
+			gResp = txs.undeleteRow("tb_InventoryItem", "inbInventoryItemId", req.GetInventoryItemId(), req.GetMserviceId(),
+				req.GetVersion(), "inventory item", "")
+		}
+
+		if gResp.ErrorCode == 0 {
+			err := recordItemEvent(txs.db, req.GetMserviceId(), req.GetInventoryItemId(), itemEventUndelete, 0, 0)
+			if err != nil {
+				level.Error(s.logger).Log("what", "recordItemEvent", "error", err)
+				gResp = &genericResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+			}
+		}
+
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		resp.ErrorReason = gResp.ErrorReason
+		if resp.ErrorCode == 0 {
+			resp.Version = req.GetVersion() + 1
+		}
+
+		return resp.ErrorCode == 0
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		resp = &pb.UndeleteInventoryItemResponse{ErrorCode: 501, ErrorMessage: err.Error()}
+	}
+
+	return resp, nil
+}
+
+// Helper to clear the deleted mark of a row at the given version, bumping the version.
+// A unique key violation is reported as the name of the record being in use.
+func (s *invService) undeleteRow(table string, idColumn string, id int64, mserviceId int64, version int32,
+	kind string, name string) *genericResponse {
+
+	resp := &genericResponse{}
+
+	sqlstring := `UPDATE ` + table + ` SET dtmModified = NOW(), bitIsDeleted = 0, intVersion = intVersion + 1
+	WHERE ` + idColumn + ` = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = 1`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(id, mserviceId, version)
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected != 1 {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else if isDuplicateKey(err) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = fmt.Sprintf("%s_name '%s' is in use by another %s", kind, name, kind)
+		resp.ErrorReason = reasonNameInUse
+	} else {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp
+}
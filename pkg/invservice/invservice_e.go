@@ -0,0 +1,328 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"context"
+	"expvar"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+)
+
+// default number of rows removed per transaction by a purge
+const DefaultPurgeBatchSize = 500
+
+// A table purged of soft-deleted rows. A deleted row is kept while any row of the references, deleted or not,
+// still points at it, so a purge never leaves a dangling id or makes a later undelete impossible. The reference
+// conditions compare against the candidate row as t.
+type purgeTable struct {
+	table string
+	keys  []string
+	refs  []string
+}
+
+// Tables in purge order, referencing tables before the tables they reference, so that one run can remove a deleted
+// facility together with its deleted subareas and items. Inventory events reference items and are pruned by age first.
+var purgeTables = []purgeTable{
+	{
+		table: "tb_InventoryItem",
+		keys:  []string{"inbInventoryItemId"},
+		refs: []string{
+			"SELECT 1 FROM tb_InventoryEvent AS r WHERE r.inbInventoryItemId = t.inbInventoryItemId",
+		},
+	},
+	{
+		table: "tb_Subarea",
+		keys:  []string{"inbSubareaId"},
+		refs: []string{
+			"SELECT 1 FROM tb_Subarea AS r WHERE r.inbParentSubareaId = t.inbSubareaId",
+			"SELECT 1 FROM tb_InventoryItem AS r WHERE r.inbSubareaId = t.inbSubareaId",
+		},
+	},
+	{
+		table: "tb_Facility",
+		keys:  []string{"inbFacilityId"},
+		refs: []string{
+			"SELECT 1 FROM tb_Subarea AS r WHERE r.inbFacilityId = t.inbFacilityId",
+		},
+	},
+	{
+		table: "tb_Product",
+		keys:  []string{"inbProductId"},
+		refs: []string{
+			"SELECT 1 FROM tb_InventoryItem AS r WHERE r.inbProductId = t.inbProductId",
+		},
+	},
+	{
+		table: "tb_SubareaType",
+		keys:  []string{"inbMserviceId", "intSubareaTypeId"},
+		refs: []string{
+			"SELECT 1 FROM tb_Subarea AS r WHERE r.inbMserviceId = t.inbMserviceId AND r.intSubareaTypeId = t.intSubareaTypeId",
+			`SELECT 1 FROM tb_SubareaTypeNesting AS r WHERE r.inbMserviceId = t.inbMserviceId
+			AND (r.intParentSubareaTypeId = t.intSubareaTypeId OR r.intChildSubareaTypeId = t.intSubareaTypeId)`,
+			`SELECT 1 FROM tb_SubareaTypeItemType AS r WHERE r.inbMserviceId = t.inbMserviceId
+			AND r.intSubareaTypeId = t.intSubareaTypeId`,
+		},
+	},
+	{
+		table: "tb_ItemType",
+		keys:  []string{"inbMserviceId", "intItemTypeId"},
+		refs: []string{
+			"SELECT 1 FROM tb_InventoryItem AS r WHERE r.inbMserviceId = t.inbMserviceId AND r.intItemTypeId = t.intItemTypeId",
+			"SELECT 1 FROM tb_SubareaTypeItemType AS r WHERE r.inbMserviceId = t.inbMserviceId AND r.intItemTypeId = t.intItemTypeId",
+		},
+	},
+	{
+		table: "tb_EntitySchema",
+		keys:  []string{"inbMserviceId", "chvEntityName"},
+	},
+}
+
+// Rows removed from one table by a purge, or that a dry run found could be removed.
+type PurgeCount struct {
+	Table    string
+	Rows     int64
+	Batches  int
+	Duration time.Duration
+}
+
+// Totals of the purges run by this process, published by expvar. The rows, batches and seconds are keyed by table.
+var (
+	purgeRunsVar    = expvar.NewInt("purge_runs")
+	purgeErrorsVar  = expvar.NewInt("purge_errors")
+	purgeRowsVar    = expvar.NewMap("purge_rows")
+	purgeBatchesVar = expvar.NewMap("purge_batches")
+	purgeSecondsVar = expvar.NewMap("purge_seconds")
+)
+
+// Result of a purge.
+type PurgeReport struct {
+	DryRun   bool
+	Tables   []PurgeCount
+	Duration time.Duration
+}
+
+// Hard delete rows soft deleted longer than retention ago, and inventory events older than retention, in
+// transactions of at most batchSize rows. A dry run only counts the rows that can be removed now; rows that become
+// removable once the rows referencing them are purged are not counted until those are gone.
+func (s *invService) Purge(ctx context.Context, retention time.Duration, batchSize int, dryRun bool) (*PurgeReport, error) {
+	start := time.Now()
+	report := &PurgeReport{DryRun: dryRun}
+
+	if batchSize <= 0 {
+		batchSize = DefaultPurgeBatchSize
+	}
+
+	retentionSecs := int64(retention / time.Second)
+
+	if !dryRun {
+		purgeRunsVar.Add(1)
+	}
+
+	tableStart := time.Now()
+	count, err := s.purgeEvents(ctx, retentionSecs, batchSize, dryRun)
+	report.add(count, tableStart)
+	if err != nil {
+		purgeErrorsVar.Add(1)
+		return report, err
+	}
+
+	for _, pt := range purgeTables {
+		tableStart = time.Now()
+		count, err := s.purgeTable(ctx, pt, retentionSecs, batchSize, dryRun)
+		report.add(count, tableStart)
+		if err != nil {
+			purgeErrorsVar.Add(1)
+			return report, err
+		}
+	}
+
+	report.Duration = time.Since(start)
+
+	return report, nil
+}
+
+// Helper to add the count of a table purged since start to the report, and unless it is a dry run to the totals.
+func (report *PurgeReport) add(count PurgeCount, start time.Time) {
+	count.Duration = time.Since(start)
+	report.Tables = append(report.Tables, count)
+
+	if !report.DryRun {
+		purgeRowsVar.Add(count.Table, count.Rows)
+		purgeBatchesVar.Add(count.Table, int64(count.Batches))
+		purgeSecondsVar.AddFloat(count.Table, count.Duration.Seconds())
+	}
+}
+
+// Run a purge every interval until ctx is done, logging the rows removed from each table. The totals are also
+// published by expvar as purge_runs, purge_errors, and purge_rows, purge_batches and purge_seconds per table.
+func (s *invService) RunPurgeJob(ctx context.Context, retention time.Duration, interval time.Duration, batchSize int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := s.Purge(ctx, retention, batchSize, false)
+		if err != nil && ctx.Err() == nil {
+			level.Error(s.logger).Log("what", "Purge", "error", err)
+		}
+
+		for _, count := range report.Tables {
+			level.Info(s.logger).Log("what", "purge", "table", count.Table, "rows", count.Rows,
+				"batches", count.Batches, "duration", count.Duration)
+		}
+
+		level.Info(s.logger).Log("what", "purge", "duration", report.Duration)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Helper to prune the inventory events older than the retention period.
+func (s *invService) purgeEvents(ctx context.Context, retentionSecs int64, batchSize int, dryRun bool) (PurgeCount, error) {
+	count := PurgeCount{Table: "tb_InventoryEvent"}
+
+	if dryRun {
+		sqlstring := `SELECT COUNT(*) FROM tb_InventoryEvent WHERE dtmCreated < DATE_SUB(NOW(), INTERVAL ? SECOND)`
+		err := s.pool.QueryRowContext(ctx, sqlstring, retentionSecs).Scan(&count.Rows)
+		return count, err
+	}
+
+	sqlstring := `DELETE FROM tb_InventoryEvent WHERE dtmCreated < DATE_SUB(NOW(), INTERVAL ? SECOND)
+	ORDER BY inbEventId LIMIT ?`
+
+	for ctx.Err() == nil {
+		res, err := s.pool.ExecContext(ctx, sqlstring, retentionSecs, batchSize)
+		if err != nil {
+			return count, err
+		}
+
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 0 {
+			break
+		}
+
+		count.Rows += rowsAffected
+		count.Batches++
+	}
+
+	return count, ctx.Err()
+}
+
+// Helper to remove the deleted rows of one table that are past the retention period and no longer referenced.
+// Each batch locks its candidates before removing them, so a row being undeleted at the same time is either
+// restored first and left alone, or purged first and the undelete finds nothing.
+func (s *invService) purgeTable(ctx context.Context, pt purgeTable, retentionSecs int64, batchSize int, dryRun bool) (PurgeCount, error) {
+	count := PurgeCount{Table: pt.table}
+
+	where := ` FROM ` + pt.table + ` AS t
+	WHERE t.bitIsDeleted = 1 AND t.dtmDeleted < DATE_SUB(NOW(), INTERVAL ? SECOND)`
+	for _, ref := range pt.refs {
+		where += `
+	AND NOT EXISTS (` + ref + `)`
+	}
+
+	if dryRun {
+		err := s.pool.QueryRowContext(ctx, `SELECT COUNT(*)`+where, retentionSecs).Scan(&count.Rows)
+		return count, err
+	}
+
+	keyList := "t." + strings.Join(pt.keys, ", t.")
+	selectString := `SELECT ` + keyList + where + ` ORDER BY ` + keyList + ` LIMIT ? FOR UPDATE`
+
+	for ctx.Err() == nil {
+		n, err := s.purgeBatch(ctx, pt, selectString, retentionSecs, batchSize)
+		if err != nil {
+			return count, err
+		}
+
+		if n == 0 {
+			break
+		}
+
+		count.Rows += n
+		count.Batches++
+	}
+
+	return count, ctx.Err()
+}
+
+// Helper to remove one batch of rows in a transaction, returning the number removed.
+func (s *invService) purgeBatch(ctx context.Context, pt purgeTable, selectString string, retentionSecs int64,
+	batchSize int) (int64, error) {
+
+	tx, err := s.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, selectString, retentionSecs, batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var args []interface{}
+	var tuples []string
+	tuple := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(pt.keys)), ", ") + ")"
+
+	for rows.Next() {
+		key := make([]interface{}, len(pt.keys))
+		ptrs := make([]interface{}, len(pt.keys))
+		for i := range key {
+			ptrs[i] = &key[i]
+		}
+
+		err = rows.Scan(ptrs...)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+
+		args = append(args, key...)
+		tuples = append(tuples, tuple)
+	}
+
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(tuples) == 0 {
+		return 0, nil
+	}
+
+	deleteString := `DELETE FROM ` + pt.table + ` WHERE (` + strings.Join(pt.keys, ", ") + `) IN (` +
+		strings.Join(tuples, ", ") + `) AND bitIsDeleted = 1`
+
+	res, err := tx.ExecContext(ctx, deleteString, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return rowsAffected, nil
+}
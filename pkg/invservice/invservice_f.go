@@ -0,0 +1,287 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// default time a request id is remembered
+const DefaultIdempotencyWindow = 24 * time.Hour
+
+// maximum length of a request id
+const maxRequestIdLength = 255
+
+// expired request ids removed each time a new one is stored
+const requestIdCleanupLimit = 100
+
+// A create request that can carry an idempotency key.
+type createRequest interface {
+	proto.Message
+	GetMserviceId() int64
+	GetRequestId() string
+}
+
+// A create response, remembered for a request id.
+type createResponse interface {
+	proto.Message
+	GetErrorCode() int32
+}
+
+// Set how long a request id is remembered.
+func (s *invService) SetIdempotencyWindow(window time.Duration) {
+	s.idempotencyWindow = window
+}
+
+// Helper to run a create at most once per request id and account. The first call with a request id runs create on
+// a copy of the request without the request id, and remembers the response if the create succeeds. Later calls with
+// the same request id get that response back, as long as they repeat the same create with the same fields. The key
+// row is inserted before the create, in the same transaction, so a retry racing the original waits for it.
+func (s *invService) createOnce(req createRequest, resp createResponse, create func(txs *invService, once proto.Message) createResponse) {
+	requestId := req.GetRequestId()
+	if len(requestId) > maxRequestIdLength {
+		setResponseError(resp, 510, fmt.Sprintf("request_id longer than %d characters", maxRequestIdLength), "")
+		return
+	}
+
+	once := proto.Clone(req)
+	once.ProtoReflect().Clear(once.ProtoReflect().Descriptor().Fields().ByName("request_id"))
+
+	hash, err := requestHash(once)
+	if err != nil {
+		setResponseError(resp, 510, err.Error(), "")
+		return
+	}
+
+	stored := false
+
+	err = s.inTransaction(func(txs *invService) bool {
+		replayed, gResp := txs.claimRequestId(req.GetMserviceId(), requestId, hash, resp)
+		if gResp.ErrorCode != 0 {
+			setResponseError(resp, gResp.ErrorCode, gResp.ErrorMessage, gResp.ErrorReason)
+			return false
+		}
+
+		if replayed {
+			return false
+		}
+
+		proto.Reset(resp)
+		proto.Merge(resp, create(txs, once))
+		if resp.GetErrorCode() != 0 {
+			return false
+		}
+
+		gResp = txs.saveRequestId(req.GetMserviceId(), requestId, resp)
+		if gResp.ErrorCode != 0 {
+			setResponseError(resp, gResp.ErrorCode, gResp.ErrorMessage, gResp.ErrorReason)
+			return false
+		}
+
+		stored = true
+
+		return true
+	})
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "inTransaction", "error", err)
+		setResponseError(resp, 501, err.Error(), "")
+		return
+	}
+
+	if stored {
+		s.expireRequestIds(req.GetMserviceId())
+	}
+}
+
+// Helper to reserve a request id, or to read the response remembered for it into resp. Returns true if resp holds
+// the remembered response. An expired request id is reserved again as if new.
+func (s *invService) claimRequestId(mserviceId int64, requestId string, hash string, resp proto.Message) (bool, *genericResponse) {
+	gResp := &genericResponse{}
+
+	sqlstring := `INSERT INTO tb_IdempotencyKey (inbMserviceId, chvRequestId, dtmCreated, chvRequestHash, chvResponse)
+	VALUES(?, ?, NOW(), ?, '')`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		gResp.ErrorCode = 500
+		gResp.ErrorMessage = "db.Prepare failed"
+		return false, gResp
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(mserviceId, requestId, hash)
+	if err == nil {
+		return false, gResp
+	}
+
+	if !isDuplicateKey(err) {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		gResp.ErrorCode = 501
+		gResp.ErrorMessage = err.Error()
+		return false, gResp
+	}
+
+	sqlstring = `SELECT chvRequestHash, chvResponse, dtmCreated < DATE_SUB(NOW(), INTERVAL ? SECOND)
+	FROM tb_IdempotencyKey WHERE inbMserviceId = ? AND chvRequestId = ? FOR UPDATE`
+
+	stmt2, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		gResp.ErrorCode = 500
+		gResp.ErrorMessage = "db.Prepare failed"
+		return false, gResp
+	}
+
+	defer stmt2.Close()
+
+	var storedHash string
+	var response string
+	var expired bool
+
+	err = stmt2.QueryRow(int64(s.idempotencyWindow/time.Second), mserviceId, requestId).Scan(&storedHash, &response, &expired)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		gResp.ErrorCode = 500
+		gResp.ErrorMessage = err.Error()
+		return false, gResp
+	}
+
+	if expired {
+		sqlstring = `UPDATE tb_IdempotencyKey SET dtmCreated = NOW(), chvRequestHash = ?, chvResponse = ''
+		WHERE inbMserviceId = ? AND chvRequestId = ?`
+
+		stmt3, err := s.db.Prepare(sqlstring)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Prepare", "error", err)
+			gResp.ErrorCode = 500
+			gResp.ErrorMessage = "db.Prepare failed"
+			return false, gResp
+		}
+
+		defer stmt3.Close()
+
+		_, err = stmt3.Exec(hash, mserviceId, requestId)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Exec", "error", err)
+			gResp.ErrorCode = 501
+			gResp.ErrorMessage = err.Error()
+		}
+
+		return false, gResp
+	}
+
+	if storedHash != hash {
+		gResp.ErrorCode = 510
+		gResp.ErrorMessage = fmt.Sprintf("request_id '%s' has already been used for a different request", requestId)
+		gResp.ErrorReason = reasonRequestIdReused
+		return false, gResp
+	}
+
+	err = protojson.Unmarshal([]byte(response), resp)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Unmarshal", "error", err)
+		gResp.ErrorCode = 500
+		gResp.ErrorMessage = err.Error()
+		return false, gResp
+	}
+
+	return true, gResp
+}
+
+// Helper to remember the response of the create reserved under a request id.
+func (s *invService) saveRequestId(mserviceId int64, requestId string, resp proto.Message) *genericResponse {
+	gResp := &genericResponse{}
+
+	response, err := protojson.Marshal(resp)
+	if err != nil {
+		gResp.ErrorCode = 500
+		gResp.ErrorMessage = err.Error()
+		return gResp
+	}
+
+	sqlstring := `UPDATE tb_IdempotencyKey SET chvResponse = ? WHERE inbMserviceId = ? AND chvRequestId = ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		gResp.ErrorCode = 500
+		gResp.ErrorMessage = "db.Prepare failed"
+		return gResp
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(string(response), mserviceId, requestId)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		gResp.ErrorCode = 501
+		gResp.ErrorMessage = err.Error()
+	}
+
+	return gResp
+}
+
+// Helper to remove some of the expired request ids of an account. Errors are only logged.
+func (s *invService) expireRequestIds(mserviceId int64) {
+	sqlstring := `DELETE FROM tb_IdempotencyKey WHERE inbMserviceId = ? AND dtmCreated < DATE_SUB(NOW(), INTERVAL ? SECOND)
+	LIMIT ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		return
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(mserviceId, int64(s.idempotencyWindow/time.Second), requestIdCleanupLimit)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+	}
+}
+
+// Helper to hash a request together with its message type, so that a request id reused for another method differs.
+func requestHash(req proto.Message) (string, error) {
+	buf, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(req.ProtoReflect().Descriptor().FullName()))
+	h.Write([]byte{0})
+	h.Write(buf)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Helper to set the error code, message and reason of a response message.
+func setResponseError(resp proto.Message, errorCode int32, errorMessage string, errorReason string) {
+	m := resp.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	m.Set(fields.ByName("error_code"), protoreflect.ValueOfInt32(errorCode))
+	m.Set(fields.ByName("error_message"), protoreflect.ValueOfString(errorMessage))
+	m.Set(fields.ByName("error_reason"), protoreflect.ValueOfString(errorReason))
+}
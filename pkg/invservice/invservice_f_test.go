@@ -0,0 +1,68 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"testing"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRequestHash(t *testing.T) {
+	hash := func(req proto.Message) string {
+		h, err := requestHash(req)
+		if err != nil {
+			t.Fatalf("requestHash(%v) failed: %v", req, err)
+		}
+
+		return h
+	}
+
+	base := hash(&pb.CreateProductRequest{MserviceId: 1, Sku: "W20", ProductName: "Widget"})
+
+	if len(base) != 64 {
+		t.Errorf("hash %q is not hex sha256", base)
+	}
+
+	if h := hash(&pb.CreateProductRequest{ProductName: "Widget", Sku: "W20", MserviceId: 1}); h != base {
+		t.Errorf("same request hashed differently: %s, %s", h, base)
+	}
+
+	tests := []struct {
+		name string
+		req  proto.Message
+	}{
+		{"other field value", &pb.CreateProductRequest{MserviceId: 1, Sku: "W21", ProductName: "Widget"}},
+		{"other account", &pb.CreateProductRequest{MserviceId: 2, Sku: "W20", ProductName: "Widget"}},
+		{"added field", &pb.CreateProductRequest{MserviceId: 1, Sku: "W20", ProductName: "Widget", Comment: " "}},
+		{"moved text", &pb.CreateProductRequest{MserviceId: 1, Sku: "W20Widget"}},
+		{"other method", &pb.CreateFacilityRequest{MserviceId: 1}},
+	}
+
+	seen := map[string]string{base: "base"}
+	for _, tt := range tests {
+		h := hash(tt.req)
+		if other, ok := seen[h]; ok {
+			t.Errorf("%s hashed the same as %s", tt.name, other)
+		}
+
+		seen[h] = tt.name
+	}
+
+	// the message type is part of the hash, so even empty requests of two methods differ
+	if hash(&pb.CreateFacilityRequest{}) == hash(&pb.CreateProductRequest{}) {
+		t.Errorf("empty requests of two methods hashed the same")
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+// maximum number of ids in a get by ids
+const maxGetIds = 1000
+
+// get subareas by a list of ids
+func (s *invService) GetSubareasByIds(ctx context.Context, req *pb.GetSubareasByIdsRequest) (*pb.GetSubareasByIdsResponse, error) {
+	resp := &pb.GetSubareasByIdsResponse{}
+
+	ids, msg := checkGetIds(req.GetSubareaIds(), "subarea_ids")
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	sqlstring := `SELECT s.inbSubareaId, s.dtmCreated, s.dtmModified, s.intVersion, s.inbMserviceId, s.inbFacilityId,
+	s.inbParentSubareaId, s.intPosition, s.intSubareaTypeId, s.chvSubareaName, s.chvJsonData, f.chvFacilityName,
+	t.chvSubareaTypeName
+	FROM tb_Subarea AS s
+	LEFT JOIN tb_Facility AS f ON s.inbFacilityId = f.inbFacilityId
+	LEFT JOIN tb_SubareaType AS t ON s.inbMserviceId = t.inbMserviceId AND s.intSubareaTypeId = t.intSubareaTypeId
+	WHERE s.inbMserviceId = ? AND s.bitIsDeleted = 0 AND s.inbSubareaId IN (?` + strings.Repeat(", ?", len(ids)-1) + `)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(getIdsArgs(req.GetMserviceId(), ids)...)
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	found := make(map[int64]*pb.Subarea)
+
+	for rows.Next() {
+		var created string
+		var modified string
+		var subarea pb.Subarea
+		var facility sql.NullString
+		var subtype sql.NullString
+
+		err := rows.Scan(&subarea.SubareaId, &created, &modified, &subarea.Version, &subarea.MserviceId,
+			&subarea.FacilityId, &subarea.ParentSubareaId, &subarea.Position, &subarea.SubareaTypeId,
+			&subarea.SubareaName, &subarea.JsonData, &facility, &subtype)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		subarea.Created = dml.DateTimeFromString(created)
+		subarea.Modified = dml.DateTimeFromString(modified)
+		if facility.Valid {
+			subarea.FacilityName = facility.String
+		}
+		if subtype.Valid {
+			subarea.SubareaTypeName = subtype.String
+		}
+
+		found[subarea.SubareaId] = &subarea
+	}
+
+	if err := rows.Err(); err != nil {
+		level.Error(s.logger).Log("what", "Next", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	for _, id := range ids {
+		if subarea, ok := found[id]; ok {
+			resp.Subareas = append(resp.Subareas, subarea)
+		} else {
+			resp.MissingIds = append(resp.MissingIds, id)
+		}
+	}
+
+	return resp, nil
+}
+
+// get products by a list of ids
+func (s *invService) GetProductsByIds(ctx context.Context, req *pb.GetProductsByIdsRequest) (*pb.GetProductsByIdsResponse, error) {
+	resp := &pb.GetProductsByIdsResponse{}
+
+	ids, msg := checkGetIds(req.GetProductIds(), "product_ids")
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	sqlstring := `SELECT inbProductId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvSku, chvProductName,
+	chvComment, chvJsonData
+	FROM tb_Product
+	WHERE inbMserviceId = ? AND bitIsDeleted = 0 AND inbProductId IN (?` + strings.Repeat(", ?", len(ids)-1) + `)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(getIdsArgs(req.GetMserviceId(), ids)...)
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	found := make(map[int64]*pb.Product)
+
+	for rows.Next() {
+		var created string
+		var modified string
+		var product pb.Product
+
+		err := rows.Scan(&product.ProductId, &created, &modified, &product.Version,
+			&product.MserviceId, &product.Sku, &product.ProductName, &product.Comment, &product.JsonData)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		product.Created = dml.DateTimeFromString(created)
+		product.Modified = dml.DateTimeFromString(modified)
+
+		found[product.ProductId] = &product
+	}
+
+	if err := rows.Err(); err != nil {
+		level.Error(s.logger).Log("what", "Next", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	for _, id := range ids {
+		if product, ok := found[id]; ok {
+			resp.Products = append(resp.Products, product)
+		} else {
+			resp.MissingIds = append(resp.MissingIds, id)
+		}
+	}
+
+	return resp, nil
+}
+
+// get inventory items by a list of ids
+func (s *invService) GetInventoryItemsByIds(ctx context.Context, req *pb.GetInventoryItemsByIdsRequest) (*pb.GetInventoryItemsByIdsResponse, error) {
+	resp := &pb.GetInventoryItemsByIdsResponse{}
+
+	ids, msg := checkGetIds(req.GetInventoryItemIds(), "inventory_item_ids")
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	sqlstring := `SELECT i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.intVersion, i.inbMserviceId,
+	i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId, i.chvJsonData,
+	t.chvItemTypeName, p.chvProductName
+	FROM tb_InventoryItem AS i
+	LEFT JOIN tb_ItemType as t ON  i.inbMserviceId = t.inbMserviceId AND i.intItemTypeId = t.intItemTypeId
+	LEFT JOIN tb_Product as p ON i.inbProductId = p.inbProductId
+	WHERE i.inbMserviceId = ? AND i.bitIsDeleted = 0 AND i.inbInventoryItemId IN (?` + strings.Repeat(", ?", len(ids)-1) + `)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(getIdsArgs(req.GetMserviceId(), ids)...)
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	found := make(map[int64]*pb.InventoryItem)
+
+	for rows.Next() {
+		var created string
+		var modified string
+		var item pb.InventoryItem
+		var typeName sql.NullString
+		var productName sql.NullString
+
+		err := rows.Scan(&item.InventoryItemId, &created, &modified,
+			&item.Version, &item.MserviceId, &item.SubareaId, &item.ItemTypeId, &item.Quantity, &item.SerialNumber,
+			&item.ProductId, &item.JsonData, &typeName, &productName)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		item.Created = dml.DateTimeFromString(created)
+		item.Modified = dml.DateTimeFromString(modified)
+		if typeName.Valid {
+			item.ItemTypeName = typeName.String
+		}
+		if productName.Valid {
+			item.ProductName = productName.String
+		}
+
+		found[item.InventoryItemId] = &item
+	}
+
+	if err := rows.Err(); err != nil {
+		level.Error(s.logger).Log("what", "Next", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	for _, id := range ids {
+		if item, ok := found[id]; ok {
+			resp.InventoryItems = append(resp.InventoryItems, item)
+		} else {
+			resp.MissingIds = append(resp.MissingIds, id)
+		}
+	}
+
+	return resp, nil
+}
+
+// Helper to check the ids of a get by ids, returning them in request order without repeats.
+func checkGetIds(ids []int64, field string) ([]int64, string) {
+	if len(ids) == 0 {
+		return nil, field + " missing"
+	}
+
+	if len(ids) > maxGetIds {
+		return nil, fmt.Sprintf("%s has more than %d ids", field, maxGetIds)
+	}
+
+	seen := make(map[int64]bool, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	return unique, ""
+}
+
+// Helper to make the query arguments of a get by ids.
+func getIdsArgs(mserviceId int64, ids []int64) []interface{} {
+	args := make([]interface{}, 0, len(ids)+1)
+	args = append(args, mserviceId)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	return args
+}
@@ -0,0 +1,386 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+// position of a synchronization in the account change sequence, carried between calls as an opaque cursor
+type changeCursor struct {
+	Seq    int64 `json:"s"`
+	Issued int64 `json:"t"`
+}
+
+// A table read by get_changes_since. The query takes the account, the last change sequence number seen and a row
+// limit, and its first column is the change sequence number of the row.
+type changeTable struct {
+	sqlstring string
+	scan      func(rows *sql.Rows, change *pb.EntityChange) error
+}
+
+// Tables read by get_changes_since. Every insert and update of these tables takes the next number of the account
+// change sequence in a trigger, see sql/tb_ChangeSequence.sql.
+var changeTables = []changeTable{
+	{
+		sqlstring: `SELECT inbChangeSeq, inbFacilityId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion,
+		inbMserviceId, chvFacilityName, chvJsonData
+		FROM tb_Facility
+		WHERE inbMserviceId = ? AND inbChangeSeq > ? ORDER BY inbChangeSeq LIMIT ?`,
+		scan: scanFacilityChange,
+	},
+	{
+		sqlstring: `SELECT inbChangeSeq, inbMserviceId, intSubareaTypeId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted,
+		intVersion, chvSubareaTypeName
+		FROM tb_SubareaType
+		WHERE inbMserviceId = ? AND inbChangeSeq > ? ORDER BY inbChangeSeq LIMIT ?`,
+		scan: scanSubareaTypeChange,
+	},
+	{
+		sqlstring: `SELECT inbChangeSeq, inbMserviceId, intItemTypeId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted,
+		intVersion, chvItemTypeName
+		FROM tb_ItemType
+		WHERE inbMserviceId = ? AND inbChangeSeq > ? ORDER BY inbChangeSeq LIMIT ?`,
+		scan: scanItemTypeChange,
+	},
+	{
+		sqlstring: `SELECT s.inbChangeSeq, s.inbSubareaId, s.dtmCreated, s.dtmModified, s.dtmDeleted, s.bitIsDeleted,
+		s.intVersion, s.inbMserviceId, s.inbFacilityId, s.inbParentSubareaId, s.intPosition, s.intSubareaTypeId,
+		s.chvSubareaName, s.chvJsonData, f.chvFacilityName, t.chvSubareaTypeName
+		FROM tb_Subarea AS s
+		LEFT JOIN tb_Facility AS f ON s.inbFacilityId = f.inbFacilityId
+		LEFT JOIN tb_SubareaType AS t ON s.inbMserviceId = t.inbMserviceId AND s.intSubareaTypeId = t.intSubareaTypeId
+		WHERE s.inbMserviceId = ? AND s.inbChangeSeq > ? ORDER BY s.inbChangeSeq LIMIT ?`,
+		scan: scanSubareaChange,
+	},
+	{
+		sqlstring: `SELECT inbChangeSeq, inbProductId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion,
+		inbMserviceId, chvSku, chvProductName, chvComment, chvJsonData
+		FROM tb_Product
+		WHERE inbMserviceId = ? AND inbChangeSeq > ? ORDER BY inbChangeSeq LIMIT ?`,
+		scan: scanProductChange,
+	},
+	{
+		sqlstring: `SELECT i.inbChangeSeq, i.inbInventoryItemId, i.dtmCreated, i.dtmModified, i.dtmDeleted, i.bitIsDeleted,
+		i.intVersion, i.inbMserviceId, i.inbSubareaId, i.intItemTypeId, i.intQuantity, i.chvSerialNumber, i.inbProductId,
+		i.chvJsonData, t.chvItemTypeName, p.chvProductName
+		FROM tb_InventoryItem AS i
+		LEFT JOIN tb_ItemType AS t ON i.inbMserviceId = t.inbMserviceId AND i.intItemTypeId = t.intItemTypeId
+		LEFT JOIN tb_Product AS p ON i.inbProductId = p.inbProductId
+		WHERE i.inbMserviceId = ? AND i.inbChangeSeq > ? ORDER BY i.inbChangeSeq LIMIT ?`,
+		scan: scanInventoryItemChange,
+	},
+}
+
+// Set the purge retention, after which a get_changes_since cursor expires because deleted rows it has not seen
+// may have been purged.
+func (s *invService) SetPurgeRetention(retention time.Duration) {
+	s.purgeRetention = retention
+}
+
+// get the facilities, subareas, products, items and types created, updated or deleted after a cursor
+func (s *invService) GetChangesSince(ctx context.Context, req *pb.GetChangesSinceRequest) (*pb.GetChangesSinceResponse, error) {
+	resp := &pb.GetChangesSinceResponse{}
+
+	pageSize := req.GetPageSize()
+	if pageSize < 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "page_size must not be negative"
+		return resp, nil
+	}
+
+	if (pageSize == 0) || (pageSize > maxPageSize) {
+		pageSize = maxPageSize
+	}
+
+	lastSeq, msg := s.parseChangeCursor(req.GetCursor())
+	if msg != "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = msg
+		return resp, nil
+	}
+
+	issued := time.Now().Unix()
+
+	changes, err := s.readChanges(ctx, req.GetMserviceId(), lastSeq, int(pageSize)+1)
+	if err != nil {
+		level.Error(s.logger).Log("what", "readChanges", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if len(changes) > int(pageSize) {
+		changes = changes[:pageSize]
+		resp.HasMore = true
+	}
+
+	if len(changes) > 0 {
+		lastSeq = changes[len(changes)-1].GetChangeSeq()
+	}
+
+	resp.Changes = changes
+	resp.NextCursor = changeCursorString(lastSeq, issued)
+
+	return resp, nil
+}
+
+// Helper to read the changes of an account after a change sequence number, at most limit of them, in change sequence
+// order. The tables are read in one snapshot; as the changes of an account commit in sequence order, the snapshot
+// holds every change up to the last one returned.
+func (s *invService) readChanges(ctx context.Context, mserviceId int64, lastSeq int64, limit int) ([]*pb.EntityChange, error) {
+	tx, err := s.pool.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	changes := make([]*pb.EntityChange, 0)
+
+	for _, ct := range changeTables {
+		rows, err := tx.QueryContext(ctx, ct.sqlstring, mserviceId, lastSeq, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for rows.Next() {
+			change := &pb.EntityChange{}
+			err = ct.scan(rows, change)
+			if err != nil {
+				rows.Close()
+				return nil, err
+			}
+
+			changes = append(changes, change)
+		}
+
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].GetChangeSeq() < changes[j].GetChangeSeq() })
+
+	if len(changes) > limit {
+		changes = changes[:limit]
+	}
+
+	return changes, nil
+}
+
+// Helper to get the last change sequence number seen from a cursor, -1 to start from the first change.
+func (s *invService) parseChangeCursor(cursor string) (int64, string) {
+	if cursor == "" {
+		return -1, ""
+	}
+
+	buf, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, "cursor invalid"
+	}
+
+	var cc changeCursor
+	err = json.Unmarshal(buf, &cc)
+	if (err != nil) || (cc.Seq < -1) {
+		return 0, "cursor invalid"
+	}
+
+	if (s.purgeRetention > 0) && (time.Since(time.Unix(cc.Issued, 0)) > s.purgeRetention) {
+		return 0, "cursor is older than the purge retention, synchronize again without a cursor"
+	}
+
+	return cc.Seq, ""
+}
+
+// Helper to encode a change sequence number and the time it was read as a cursor.
+func changeCursorString(seq int64, issued int64) string {
+	buf, _ := json.Marshal(changeCursor{Seq: seq, Issued: issued})
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// Helper to fill in the fields common to every change. A deleted entity is left as a tombstone.
+func setChange(change *pb.EntityChange, entityType string, entityId int64, version int32, isDeleted bool, deleted string) bool {
+	change.EntityType = entityType
+	change.EntityId = entityId
+	change.Version = version
+	change.IsDeleted = isDeleted
+	if isDeleted {
+		change.Deleted = dml.DateTimeFromString(deleted)
+	}
+
+	return !isDeleted
+}
+
+// Helper to scan a facility change.
+func scanFacilityChange(rows *sql.Rows, change *pb.EntityChange) error {
+	var created string
+	var modified string
+	var deleted string
+	var facility pb.Facility
+
+	err := rows.Scan(&change.ChangeSeq, &facility.FacilityId, &created, &modified, &deleted, &facility.IsDeleted,
+		&facility.Version, &facility.MserviceId, &facility.FacilityName, &facility.JsonData)
+	if err != nil {
+		return err
+	}
+
+	if setChange(change, "facility", facility.FacilityId, facility.Version, facility.IsDeleted, deleted) {
+		facility.Created = dml.DateTimeFromString(created)
+		facility.Modified = dml.DateTimeFromString(modified)
+		change.Entity = &pb.EntityChange_Facility{Facility: &facility}
+	}
+
+	return nil
+}
+
+// Helper to scan a subarea type change.
+func scanSubareaTypeChange(rows *sql.Rows, change *pb.EntityChange) error {
+	var created string
+	var modified string
+	var deleted string
+	var subtype pb.SubareaType
+
+	err := rows.Scan(&change.ChangeSeq, &subtype.MserviceId, &subtype.SubareaTypeId, &created, &modified, &deleted,
+		&subtype.IsDeleted, &subtype.Version, &subtype.SubareaTypeName)
+	if err != nil {
+		return err
+	}
+
+	if setChange(change, "subarea_type", int64(subtype.SubareaTypeId), subtype.Version, subtype.IsDeleted, deleted) {
+		subtype.Created = dml.DateTimeFromString(created)
+		subtype.Modified = dml.DateTimeFromString(modified)
+		change.Entity = &pb.EntityChange_SubareaType{SubareaType: &subtype}
+	}
+
+	return nil
+}
+
+// Helper to scan an item type change.
+func scanItemTypeChange(rows *sql.Rows, change *pb.EntityChange) error {
+	var created string
+	var modified string
+	var deleted string
+	var itemtype pb.ItemType
+
+	err := rows.Scan(&change.ChangeSeq, &itemtype.MserviceId, &itemtype.ItemTypeId, &created, &modified, &deleted,
+		&itemtype.IsDeleted, &itemtype.Version, &itemtype.ItemTypeName)
+	if err != nil {
+		return err
+	}
+
+	if setChange(change, "item_type", int64(itemtype.ItemTypeId), itemtype.Version, itemtype.IsDeleted, deleted) {
+		itemtype.Created = dml.DateTimeFromString(created)
+		itemtype.Modified = dml.DateTimeFromString(modified)
+		change.Entity = &pb.EntityChange_ItemType{ItemType: &itemtype}
+	}
+
+	return nil
+}
+
+// Helper to scan a subarea change.
+func scanSubareaChange(rows *sql.Rows, change *pb.EntityChange) error {
+	var created string
+	var modified string
+	var deleted string
+	var subarea pb.Subarea
+	var facility sql.NullString
+	var subtype sql.NullString
+
+	err := rows.Scan(&change.ChangeSeq, &subarea.SubareaId, &created, &modified, &deleted, &subarea.IsDeleted,
+		&subarea.Version, &subarea.MserviceId, &subarea.FacilityId, &subarea.ParentSubareaId, &subarea.Position,
+		&subarea.SubareaTypeId, &subarea.SubareaName, &subarea.JsonData, &facility, &subtype)
+	if err != nil {
+		return err
+	}
+
+	if setChange(change, "subarea", subarea.SubareaId, subarea.Version, subarea.IsDeleted, deleted) {
+		subarea.Created = dml.DateTimeFromString(created)
+		subarea.Modified = dml.DateTimeFromString(modified)
+		if facility.Valid {
+			subarea.FacilityName = facility.String
+		}
+		if subtype.Valid {
+			subarea.SubareaTypeName = subtype.String
+		}
+		change.Entity = &pb.EntityChange_Subarea{Subarea: &subarea}
+	}
+
+	return nil
+}
+
+// Helper to scan a product change.
+func scanProductChange(rows *sql.Rows, change *pb.EntityChange) error {
+	var created string
+	var modified string
+	var deleted string
+	var product pb.Product
+
+	err := rows.Scan(&change.ChangeSeq, &product.ProductId, &created, &modified, &deleted, &product.IsDeleted,
+		&product.Version, &product.MserviceId, &product.Sku, &product.ProductName, &product.Comment, &product.JsonData)
+	if err != nil {
+		return err
+	}
+
+	if setChange(change, "product", product.ProductId, product.Version, product.IsDeleted, deleted) {
+		product.Created = dml.DateTimeFromString(created)
+		product.Modified = dml.DateTimeFromString(modified)
+		change.Entity = &pb.EntityChange_Product{Product: &product}
+	}
+
+	return nil
+}
+
+// Helper to scan an inventory item change.
+func scanInventoryItemChange(rows *sql.Rows, change *pb.EntityChange) error {
+	var created string
+	var modified string
+	var deleted string
+	var item pb.InventoryItem
+	var typeName sql.NullString
+	var productName sql.NullString
+
+	err := rows.Scan(&change.ChangeSeq, &item.InventoryItemId, &created, &modified, &deleted, &item.IsDeleted,
+		&item.Version, &item.MserviceId, &item.SubareaId, &item.ItemTypeId, &item.Quantity, &item.SerialNumber,
+		&item.ProductId, &item.JsonData, &typeName, &productName)
+	if err != nil {
+		return err
+	}
+
+	if setChange(change, "inventory_item", item.InventoryItemId, item.Version, item.IsDeleted, deleted) {
+		item.Created = dml.DateTimeFromString(created)
+		item.Modified = dml.DateTimeFromString(modified)
+		if typeName.Valid {
+			item.ItemTypeName = typeName.String
+		}
+		if productName.Valid {
+			item.ProductName = productName.String
+		}
+		change.Entity = &pb.EntityChange_InventoryItem{InventoryItem: &item}
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/go-kit/kit/log/level"
+)
+
+// maximum number of ancestors followed when checking a new parent subarea
+const maxSubareaDepth = 1000
+
+// A foreign reference of a record being written: the request field, and the table and key column it refers to.
+// A zero id is no reference unless the reference is required.
+type reference struct {
+	field    string
+	table    string
+	column   string
+	id       int64
+	required bool
+}
+
+// Helper to check the facility, parent subarea and subarea type of a subarea being created, or being updated if
+// subareaId is not zero, returning a field-specific message if one is not a live row of the account. The facility of
+// an existing subarea cannot change, so only a new subarea has its facility checked.
+func (s *invService) checkSubareaReferences(q dbQueryer, mserviceId int64, subareaId int64, facilityId int64,
+	parentSubareaId int64, subareaTypeId int32) (string, error) {
+
+	refs := []reference{
+		{field: "parent_subarea_id", table: "tb_Subarea", column: "inbSubareaId", id: parentSubareaId},
+		{field: "subarea_type_id", table: "tb_SubareaType", column: "intSubareaTypeId", id: int64(subareaTypeId)},
+	}
+
+	if subareaId == 0 {
+		refs = append([]reference{
+			{field: "facility_id", table: "tb_Facility", column: "inbFacilityId", id: facilityId, required: true},
+		}, refs...)
+	}
+
+	msg, err := s.checkReferences(q, mserviceId, refs)
+	if (err != nil) || (msg != "") || (parentSubareaId == 0) {
+		return msg, err
+	}
+
+	return s.checkSubareaParent(q, mserviceId, subareaId, facilityId, parentSubareaId)
+}
+
+// Helper to check the subarea, item type and product of an inventory item being created or updated, returning a
+// field-specific message if one is not a live row of the account.
+func (s *invService) checkItemReferences(q dbQueryer, mserviceId int64, subareaId int64, itemTypeId int32,
+	productId int64) (string, error) {
+
+	return s.checkReferences(q, mserviceId, []reference{
+		{field: "subarea_id", table: "tb_Subarea", column: "inbSubareaId", id: subareaId, required: true},
+		{field: "item_type_id", table: "tb_ItemType", column: "intItemTypeId", id: int64(itemTypeId)},
+		{field: "product_id", table: "tb_Product", column: "inbProductId", id: productId},
+	})
+}
+
+// Helper to check that each reference is to a live row of the account. The rows found are share locked, so within
+// a transaction they cannot be deleted before it commits. A row of another account is reported as not found.
+func (s *invService) checkReferences(q dbQueryer, mserviceId int64, refs []reference) (string, error) {
+	for _, ref := range refs {
+		if ref.id == 0 {
+			if ref.required {
+				return ref.field + " missing", nil
+			}
+			continue
+		}
+
+		sqlstring := `SELECT bitIsDeleted FROM ` + ref.table + ` WHERE ` + ref.column + ` = ? AND inbMserviceId = ?
+		LOCK IN SHARE MODE`
+
+		stmt, err := q.Prepare(sqlstring)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Prepare", "error", err)
+			return "", err
+		}
+
+		var isDeleted bool
+		err = stmt.QueryRow(ref.id, mserviceId).Scan(&isDeleted)
+		stmt.Close()
+
+		if err == sql.ErrNoRows {
+			return fmt.Sprintf("%s %d not found", ref.field, ref.id), nil
+		}
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "QueryRow", "error", err)
+			return "", err
+		}
+
+		if isDeleted {
+			return fmt.Sprintf("%s %d is deleted", ref.field, ref.id), nil
+		}
+	}
+
+	return "", nil
+}
+
+// Helper to check that a parent subarea is in the facility of the subarea, and for an existing subarea that the
+// parent is not the subarea itself or one of its descendants. The ancestors are share locked as they are read, so
+// the walk sees the latest committed parents and a concurrent move of one of them waits for this write to commit.
+func (s *invService) checkSubareaParent(q dbQueryer, mserviceId int64, subareaId int64, facilityId int64,
+	parentSubareaId int64) (string, error) {
+
+	sqlstring := `SELECT inbFacilityId, inbParentSubareaId FROM tb_Subarea WHERE inbSubareaId = ? AND inbMserviceId = ?
+	LOCK IN SHARE MODE`
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		return "", err
+	}
+
+	defer stmt.Close()
+
+	id := parentSubareaId
+	for depth := 0; (id != 0) && (depth < maxSubareaDepth); depth++ {
+		if id == subareaId {
+			return fmt.Sprintf("parent_subarea_id %d is subarea %d or one of its descendants", parentSubareaId, subareaId), nil
+		}
+
+		var ancestorFacilityId int64
+		err = stmt.QueryRow(id, mserviceId).Scan(&ancestorFacilityId, &id)
+		if err == sql.ErrNoRows {
+			break
+		}
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "QueryRow", "error", err)
+			return "", err
+		}
+
+		if (depth == 0) && (facilityId != 0) && (ancestorFacilityId != facilityId) {
+			return fmt.Sprintf("parent_subarea_id %d is in facility %d, not facility %d", parentSubareaId,
+				ancestorFacilityId, facilityId), nil
+		}
+
+		if subareaId == 0 {
+			break
+		}
+	}
+
+	return "", nil
+}
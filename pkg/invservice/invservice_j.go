@@ -0,0 +1,262 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"fmt"
+
+	"github.com/go-kit/kit/log/level"
+)
+
+// A live record that refers to a row being deleted: an inventory item with its subarea and product, or a subarea
+// with its parent subarea.
+type dependent struct {
+	id        int64
+	subareaId int64
+	productId int64
+}
+
+// Helper to refuse the delete of a product that live inventory items still refer to, or with reassign_to to move
+// those items to another product. Returns the number of items moved, or a message and its reason if the delete is
+// refused.
+func (s *invService) releaseProduct(mserviceId int64, productId int64, reassignTo int64) (int32, string, string, error) {
+	sqlstring := `SELECT inbInventoryItemId, inbSubareaId, inbProductId FROM tb_InventoryItem
+	WHERE inbMserviceId = ? AND inbProductId = ? AND bitIsDeleted = 0 FOR UPDATE`
+
+	items, err := lockDependents(s.db, sqlstring, mserviceId, productId)
+	if (err != nil) || (len(items) == 0) {
+		return 0, "", "", err
+	}
+
+	msg, reason, err := s.checkReassign(mserviceId, "product", productId, len(items), "inventory item",
+		reference{field: "reassign_to", table: "tb_Product", column: "inbProductId", id: reassignTo, required: true})
+	if (err != nil) || (msg != "") {
+		return 0, msg, reason, err
+	}
+
+	err = moveDependents(s.db, "tb_InventoryItem", "inbProductId", mserviceId, productId, reassignTo)
+	if err == nil {
+		err = s.recordMovedItems(mserviceId, items)
+	}
+
+	return int32(len(items)), "", "", err
+}
+
+// Helper to refuse the delete of an item type that type rules or live inventory items still refer to, or with
+// reassign_to to move those items to another item type allowed in their subareas. Returns the number of items moved, or a message
+// and its reason if the delete is refused.
+func (s *invService) releaseItemType(mserviceId int64, itemTypeId int32, reassignTo int32) (int32, string, string, error) {
+	msg, err := checkTypeRules(s.db, mserviceId, "item type", itemTypeId,
+		`SELECT COUNT(*) FROM tb_SubareaTypeItemType WHERE inbMserviceId = ? AND intItemTypeId = ? FOR UPDATE`)
+	if (err != nil) || (msg != "") {
+		return 0, msg, reasonFor(msg, reasonHasDependents), err
+	}
+
+	sqlstring := `SELECT inbInventoryItemId, inbSubareaId, inbProductId FROM tb_InventoryItem
+	WHERE inbMserviceId = ? AND intItemTypeId = ? AND bitIsDeleted = 0 FOR UPDATE`
+
+	items, err := lockDependents(s.db, sqlstring, mserviceId, int64(itemTypeId))
+	if (err != nil) || (len(items) == 0) {
+		return 0, "", "", err
+	}
+
+	msg, reason, err := s.checkReassign(mserviceId, "item type", int64(itemTypeId), len(items), "inventory item",
+		reference{field: "reassign_to", table: "tb_ItemType", column: "intItemTypeId", id: int64(reassignTo), required: true})
+	if (err != nil) || (msg != "") {
+		return 0, msg, reason, err
+	}
+
+	checked := make(map[int64]bool)
+	for _, item := range items {
+		if checked[item.subareaId] {
+			continue
+		}
+
+		checked[item.subareaId] = true
+		msg, err = s.checkItemPlacement(s.db, mserviceId, item.subareaId, reassignTo)
+		if (err != nil) || (msg != "") {
+			return 0, msg, reasonFor(msg, reasonTypeRule), err
+		}
+	}
+
+	err = moveDependents(s.db, "tb_InventoryItem", "intItemTypeId", mserviceId, int64(itemTypeId), int64(reassignTo))
+	if err == nil {
+		err = s.recordMovedItems(mserviceId, items)
+	}
+
+	return int32(len(items)), "", "", err
+}
+
+// Helper to refuse the delete of a subarea type that type rules or live subareas still refer to, or with
+// reassign_to to move those subareas to another subarea type. The subareas are moved first and then checked against the nesting and
+// item type rules, so subareas of the old type nested in each other are checked with their new types. Returns the
+// number of subareas moved, or a message and its reason if the delete is refused.
+func (s *invService) releaseSubareaType(mserviceId int64, subareaTypeId int32, reassignTo int32) (int32, string, string, error) {
+	msg, err := checkTypeRules(s.db, mserviceId, "subarea type", subareaTypeId,
+		`SELECT COUNT(*) FROM tb_SubareaTypeNesting WHERE inbMserviceId = ?
+		AND ? IN (intParentSubareaTypeId, intChildSubareaTypeId) FOR UPDATE`,
+		`SELECT COUNT(*) FROM tb_SubareaTypeItemType WHERE inbMserviceId = ? AND intSubareaTypeId = ? FOR UPDATE`)
+	if (err != nil) || (msg != "") {
+		return 0, msg, reasonFor(msg, reasonHasDependents), err
+	}
+
+	sqlstring := `SELECT inbSubareaId, inbParentSubareaId, 0 FROM tb_Subarea
+	WHERE inbMserviceId = ? AND intSubareaTypeId = ? AND bitIsDeleted = 0 FOR UPDATE`
+
+	subareas, err := lockDependents(s.db, sqlstring, mserviceId, int64(subareaTypeId))
+	if (err != nil) || (len(subareas) == 0) {
+		return 0, "", "", err
+	}
+
+	msg, reason, err := s.checkReassign(mserviceId, "subarea type", int64(subareaTypeId), len(subareas), "subarea",
+		reference{field: "reassign_to", table: "tb_SubareaType", column: "intSubareaTypeId", id: int64(reassignTo), required: true})
+	if (err != nil) || (msg != "") {
+		return 0, msg, reason, err
+	}
+
+	err = moveDependents(s.db, "tb_Subarea", "intSubareaTypeId", mserviceId, int64(subareaTypeId), int64(reassignTo))
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	for _, subarea := range subareas {
+		msg, err = s.checkSubareaPlacement(s.db, mserviceId, subarea.subareaId, reassignTo)
+		if (err == nil) && (msg == "") {
+			msg, err = s.checkSubareaContents(s.db, mserviceId, subarea.id, reassignTo)
+		}
+
+		if (err != nil) || (msg != "") {
+			return 0, msg, reasonFor(msg, reasonTypeRule), err
+		}
+	}
+
+	return int32(len(subareas)), "", "", nil
+}
+
+// Helper to refuse the delete of a subarea type or item type that nesting or item type rules still name. Dropping
+// the rules with the type could lift every restriction on a subarea type left without rules, and a rule naming the
+// type would keep the purge from ever removing it. Each query locks and counts rules, given the account and type id.
+func checkTypeRules(q dbQueryer, mserviceId int64, kind string, typeId int32, queries ...string) (string, error) {
+	count := 0
+	for _, sqlstring := range queries {
+		stmt, err := q.Prepare(sqlstring)
+		if err != nil {
+			return "", err
+		}
+
+		var rules int
+		err = stmt.QueryRow(mserviceId, typeId).Scan(&rules)
+		stmt.Close()
+		if err != nil {
+			return "", err
+		}
+
+		count += rules
+	}
+
+	if count == 0 {
+		return "", nil
+	}
+
+	rules := "rules"
+	if count == 1 {
+		rules = "rule"
+	}
+
+	return fmt.Sprintf("%s %d is named in %d type %s, delete them first", kind, typeId, count, rules), nil
+}
+
+// Helper to lock and list the live records that refer to a row being deleted. The query selects the id, subarea and
+// product of each record, given the account and the id of the row.
+func lockDependents(q dbQueryer, sqlstring string, mserviceId int64, id int64) ([]dependent, error) {
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(mserviceId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	dependents := make([]dependent, 0)
+	for rows.Next() {
+		var dep dependent
+		err = rows.Scan(&dep.id, &dep.subareaId, &dep.productId)
+		if err != nil {
+			return nil, err
+		}
+
+		dependents = append(dependents, dep)
+	}
+
+	return dependents, rows.Err()
+}
+
+// Helper to check the reassign_to of a delete whose row still has live dependents, returning a message and its
+// reason if the delete must be refused.
+func (s *invService) checkReassign(mserviceId int64, kind string, id int64, count int, dependentName string,
+	replacement reference) (string, string, error) {
+
+	if count != 1 {
+		dependentName += "s"
+	}
+
+	if replacement.id == 0 {
+		return fmt.Sprintf("%s %d is referenced by %d live %s, delete them or give reassign_to", kind, id, count,
+			dependentName), reasonHasDependents, nil
+	}
+
+	if replacement.id == id {
+		return fmt.Sprintf("reassign_to %d is the %s being deleted", id, kind), "", nil
+	}
+
+	msg, err := s.checkReferences(s.db, mserviceId, []reference{replacement})
+
+	return msg, "", err
+}
+
+// Helper to point the live records of a table that refer to a deleted row at its replacement.
+func moveDependents(q dbQueryer, table string, column string, mserviceId int64, id int64, reassignTo int64) error {
+	sqlstring := `UPDATE ` + table + ` SET dtmModified = NOW(), intVersion = intVersion + 1, ` + column + ` = ?
+	WHERE inbMserviceId = ? AND ` + column + ` = ? AND bitIsDeleted = 0`
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(reassignTo, mserviceId, id)
+
+	return err
+}
+
+// Helper to record an update event for each inventory item moved to a replacement product or item type.
+func (s *invService) recordMovedItems(mserviceId int64, items []dependent) error {
+	for _, item := range items {
+		err := recordItemEvent(s.db, mserviceId, item.id, itemEventUpdate, item.subareaId, item.productId)
+		if err != nil {
+			level.Error(s.logger).Log("what", "recordItemEvent", "error", err)
+			return err
+		}
+	}
+
+	return nil
+}
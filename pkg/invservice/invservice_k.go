@@ -0,0 +1,285 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-kit/kit/log/level"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
+)
+
+// maximum number of schema violations listed in an error message
+const maxJsonDataErrors = 5
+
+// maximum number of nonconforming records listed by an entity schema update
+const maxNonconformingRecords = 1000
+
+// Helper to compile the json schema of an entity, returning a message if it is not a well-formed JSON Schema. The
+// schema may only refer to itself and the JSON Schema meta-schemas, never to other documents.
+func compileJsonSchema(entityName string, jsonSchema string) (*jsonschema.Schema, string) {
+	if strings.TrimSpace(jsonSchema) == "" {
+		return nil, "json_schema missing"
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal([]byte(jsonSchema), &doc); err != nil {
+		return nil, "json_schema is not valid json: " + err.Error()
+	}
+
+	url := "schema://inventory/" + entityName
+	compiler := jsonschema.NewCompiler()
+	compiler.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("%s cannot be loaded, schemas must be self-contained", s)
+	}
+
+	var schema *jsonschema.Schema
+	err := compiler.AddResource(url, strings.NewReader(jsonSchema))
+	if err == nil {
+		schema, err = compiler.Compile(url)
+		if err == nil {
+			return schema, ""
+		}
+	}
+
+	var serr *jsonschema.SchemaError
+	var verr *jsonschema.ValidationError
+	if errors.As(err, &verr) {
+		return nil, "json_schema is not a valid JSON Schema: " + describeViolations(verr)
+	}
+
+	if errors.As(err, &serr) && (serr.Err != nil) {
+		err = serr.Err
+	}
+
+	return nil, "json_schema is not a valid JSON Schema: " + err.Error()
+}
+
+// Helper to check the json_data of a facility, subarea, product or inventory item against the account's schema for
+// the entity, returning a message giving the JSON pointer of each violation. Without a schema, or without
+// json_data, there is nothing to check.
+func (s *invService) checkJsonData(mserviceId int64, entityName string, jsonData string) (string, error) {
+	if strings.TrimSpace(jsonData) == "" {
+		return "", nil
+	}
+
+	schema, err := s.loadJsonSchema(s.db, mserviceId, entityName)
+	if err != nil {
+		return "", err
+	}
+
+	return checkLoadedJsonData(schema, entityName, jsonData), nil
+}
+
+// Helper to load and compile the account's schema for an entity, share locked so that it cannot change before the
+// write being checked commits. The schema is nil if the account has none, or if the stored schema is not a valid
+// JSON Schema, as schemas stored before they were checked may be; the writes are then not checked and a warning is
+// logged, until the schema is updated.
+func (s *invService) loadJsonSchema(q dbQueryer, mserviceId int64, entityName string) (*jsonschema.Schema, error) {
+	sqlstring := `SELECT chvJsonSchema FROM tb_EntitySchema
+	WHERE inbMserviceId = ? AND chvEntityName = ? AND bitIsDeleted = 0 LOCK IN SHARE MODE`
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	var jsonSchema string
+	err = stmt.QueryRow(mserviceId, entityName).Scan(&jsonSchema)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		return nil, err
+	}
+
+	schema, msg := compileJsonSchema(entityName, jsonSchema)
+	if msg != "" {
+		level.Warn(s.logger).Log("what", "stored schema invalid, json_data not checked", "mservice_id", mserviceId,
+			"entity", entityName, "error", msg)
+		return nil, nil
+	}
+
+	return schema, nil
+}
+
+// Log a warning for each stored entity schema that is not a valid JSON Schema, and return how many there are. Such
+// schemas were stored before schemas were checked, and json_data is not checked against them until they are updated.
+func (s *invService) CheckStoredSchemas() (int, error) {
+	sqlstring := `SELECT inbMserviceId, chvEntityName, chvJsonSchema FROM tb_EntitySchema WHERE bitIsDeleted = 0
+	ORDER BY inbMserviceId, chvEntityName`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query()
+	if err != nil {
+		return 0, err
+	}
+
+	defer rows.Close()
+
+	invalid := 0
+	for rows.Next() {
+		var mserviceId int64
+		var entityName string
+		var jsonSchema string
+
+		err = rows.Scan(&mserviceId, &entityName, &jsonSchema)
+		if err != nil {
+			return invalid, err
+		}
+
+		if _, ok := supportedEntities[entityName]; !ok {
+			continue
+		}
+
+		if _, msg := compileJsonSchema(entityName, jsonSchema); msg != "" {
+			invalid++
+			level.Warn(s.logger).Log("what", "stored schema invalid, json_data not checked", "mservice_id", mserviceId,
+				"entity", entityName, "error", msg)
+		}
+	}
+
+	return invalid, rows.Err()
+}
+
+// Helper to check json_data against a schema from loadJsonSchema, where a missing schema or empty json_data passes.
+func checkLoadedJsonData(schema *jsonschema.Schema, entityName string, jsonData string) string {
+	if (schema == nil) || (strings.TrimSpace(jsonData) == "") {
+		return ""
+	}
+
+	return validateJsonData(schema, entityName, jsonData)
+}
+
+// Helper to check the json_data of every live record of an entity against a schema, returning the number of records
+// that do not match and the first maxNonconformingRecords of them.
+func (s *invService) scanJsonData(mserviceId int64, entityName string, schema *jsonschema.Schema) (int32,
+	[]*pb.NonconformingRecord, error) {
+
+	entity := supportedEntities[entityName]
+	sqlstring := `SELECT ` + entity.column + `, intVersion, chvJsonData FROM ` + entity.table + `
+	WHERE inbMserviceId = ? AND bitIsDeleted = 0 ORDER BY ` + entity.column
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		return 0, nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(mserviceId)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	defer rows.Close()
+
+	var count int32
+	records := make([]*pb.NonconformingRecord, 0)
+	for rows.Next() {
+		var record pb.NonconformingRecord
+		var jsonData string
+		err = rows.Scan(&record.EntityId, &record.Version, &jsonData)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if strings.TrimSpace(jsonData) == "" {
+			continue
+		}
+
+		record.ErrorMessage = validateJsonData(schema, entityName, jsonData)
+		if record.ErrorMessage == "" {
+			continue
+		}
+
+		count++
+		if len(records) < maxNonconformingRecords {
+			records = append(records, &record)
+		}
+	}
+
+	return count, records, rows.Err()
+}
+
+// Helper to validate json_data against a compiled entity schema, returning a message if it does not conform.
+func validateJsonData(schema *jsonschema.Schema, entityName string, jsonData string) string {
+	decoder := json.NewDecoder(strings.NewReader(jsonData))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "json_data is not valid json: " + err.Error()
+	}
+
+	if decoder.More() {
+		return "json_data is not valid json: unexpected data after the top-level value"
+	}
+
+	err := schema.Validate(value)
+	if err == nil {
+		return ""
+	}
+
+	var verr *jsonschema.ValidationError
+	if errors.As(err, &verr) {
+		return fmt.Sprintf("json_data does not match the %s schema: %s", entityName, describeViolations(verr))
+	}
+
+	return fmt.Sprintf("json_data does not match the %s schema: %s", entityName, err.Error())
+}
+
+// Helper to list the innermost causes of a validation error, each with the JSON pointer of the value at fault.
+func describeViolations(verr *jsonschema.ValidationError) string {
+	violations := make([]string, 0)
+	var collect func(ve *jsonschema.ValidationError)
+	collect = func(ve *jsonschema.ValidationError) {
+		if len(ve.Causes) == 0 {
+			violations = append(violations, fmt.Sprintf("'%s' %s", ve.InstanceLocation, ve.Message))
+			return
+		}
+
+		for _, cause := range ve.Causes {
+			collect(cause)
+		}
+	}
+
+	collect(verr)
+
+	if len(violations) > maxJsonDataErrors {
+		more := len(violations) - maxJsonDataErrors
+		violations = append(violations[:maxJsonDataErrors], fmt.Sprintf("and %d more", more))
+	}
+
+	return strings.Join(violations, "; ")
+}
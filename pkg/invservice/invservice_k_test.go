@@ -0,0 +1,120 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package invservice
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompileJsonSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		msg    string
+	}{
+		{"object", `{"type": "object", "properties": {"color": {"type": "string"}}}`, ""},
+		{"boolean schema", `true`, ""},
+		{"local ref", `{"$defs": {"v": {"type": "number"}}, "properties": {"volts": {"$ref": "#/$defs/v"}}}`, ""},
+		{"missing", "  ", "json_schema missing"},
+		{"not json", `{"type": `, "json_schema is not valid json: "},
+		{"bad type", `{"type": "colour"}`, "json_schema is not a valid JSON Schema: "},
+		{"bad keyword value", `{"minLength": -1}`, "json_schema is not a valid JSON Schema: '/minLength' "},
+		{"external ref", `{"$ref": "https://example.com/schema.json"}`, "json_schema is not a valid JSON Schema: "},
+		{"file ref", `{"$ref": "file:///etc/passwd"}`, "json_schema is not a valid JSON Schema: "},
+		{"missing local ref", `{"$ref": "#/$defs/none"}`, "json_schema is not a valid JSON Schema: "},
+	}
+
+	for _, tt := range tests {
+		schema, msg := compileJsonSchema("product", tt.schema)
+		if (tt.msg == "") != (msg == "") || !strings.HasPrefix(msg, tt.msg) {
+			t.Errorf("%s: message = %q, want prefix %q", tt.name, msg, tt.msg)
+			continue
+		}
+
+		if (msg == "") != (schema != nil) {
+			t.Errorf("%s: schema = %v with message %q", tt.name, schema, msg)
+		}
+	}
+
+	_, msg := compileJsonSchema("product", `{"$ref": "https://example.com/schema.json"}`)
+	if !strings.Contains(msg, "schemas must be self-contained") {
+		t.Errorf("external ref message = %q", msg)
+	}
+}
+
+func TestValidateJsonData(t *testing.T) {
+	schema, msg := compileJsonSchema("product", `{
+		"type": "object",
+		"required": ["color"],
+		"properties": {
+			"color": {"type": "string", "enum": ["red", "blue"]},
+			"volts": {"type": "integer", "maximum": 240},
+			"dims": {"type": "array", "items": {"type": "number"}},
+			"a/b": {"type": "string"},
+			"x~y": {"type": "string"}
+		}
+	}`)
+	if msg != "" {
+		t.Fatalf("compileJsonSchema failed: %s", msg)
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		contains []string
+	}{
+		{"valid", `{"color": "red", "volts": 110, "dims": [1, 2.5]}`, nil},
+		{"large integer", `{"color": "red", "volts": 120.0}`, nil},
+		{"not json", `{"color": `, []string{"json_data is not valid json: "}},
+		{"trailing data", `{"color": "red"} {}`, []string{"json_data is not valid json: unexpected data after"}},
+		{"missing property", `{}`, []string{"json_data does not match the product schema: ", "'' missing properties: 'color'"}},
+		{"wrong type", `{"color": 5}`, []string{"'/color' "}},
+		{"array item", `{"color": "red", "dims": [1, "two"]}`, []string{"'/dims/1' "}},
+		{"maximum", `{"color": "red", "volts": 250}`, []string{"'/volts' must be <= 240 but found 250"}},
+		{"escaped pointer", `{"color": "red", "a/b": 1, "x~y": 2}`, []string{"'/a~1b' ", "'/x~0y' "}},
+		{"not an object", `[]`, []string{"'' "}},
+	}
+
+	for _, tt := range tests {
+		msg := validateJsonData(schema, "product", tt.data)
+		if (tt.contains == nil) != (msg == "") {
+			t.Errorf("%s: message = %q", tt.name, msg)
+			continue
+		}
+
+		for _, want := range tt.contains {
+			if !strings.Contains(msg, want) {
+				t.Errorf("%s: message %q does not contain %q", tt.name, msg, want)
+			}
+		}
+	}
+}
+
+func TestDescribeViolationsLimit(t *testing.T) {
+	schema, msg := compileJsonSchema("facility", `{"type": "object", "additionalProperties": {"type": "string"}}`)
+	if msg != "" {
+		t.Fatalf("compileJsonSchema failed: %s", msg)
+	}
+
+	msg = validateJsonData(schema, "facility", `{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5, "f": 6, "g": 7}`)
+
+	if !strings.HasSuffix(msg, "; and 2 more") {
+		t.Errorf("message %q does not end with the count of unlisted violations", msg)
+	}
+
+	if n := strings.Count(msg, "expected string"); n != maxJsonDataErrors {
+		t.Errorf("message %q lists %d violations, want %d", msg, n, maxJsonDataErrors)
+	}
+}
@@ -24,12 +24,16 @@ type MServiceInventoryClient interface {
 	UpdateFacility(ctx context.Context, in *UpdateFacilityRequest, opts ...grpc.CallOption) (*UpdateFacilityResponse, error)
 	// delete an existing facility
 	DeleteFacility(ctx context.Context, in *DeleteFacilityRequest, opts ...grpc.CallOption) (*DeleteFacilityResponse, error)
+	// restore a deleted facility
+	UndeleteFacility(ctx context.Context, in *UndeleteFacilityRequest, opts ...grpc.CallOption) (*UndeleteFacilityResponse, error)
 	// get a facility by id
 	GetFacility(ctx context.Context, in *GetFacilityRequest, opts ...grpc.CallOption) (*GetFacilityResponse, error)
 	// get all facilities by mservice_id
 	GetFacilities(ctx context.Context, in *GetFacilitiesRequest, opts ...grpc.CallOption) (*GetFacilitiesResponse, error)
 	// get a facility wrapper by id
 	GetFacilityWrapper(ctx context.Context, in *GetFacilityWrapperRequest, opts ...grpc.CallOption) (*GetFacilityWrapperResponse, error)
+	// copy a facility and its subarea hierarchy to a new facility
+	CloneFacility(ctx context.Context, in *CloneFacilityRequest, opts ...grpc.CallOption) (*CloneFacilityResponse, error)
 	// create new subarea type
 	CreateSubareaType(ctx context.Context, in *CreateSubareaTypeRequest, opts ...grpc.CallOption) (*CreateSubareaTypeResponse, error)
 	// update an existing subarea type
@@ -50,32 +54,64 @@ type MServiceInventoryClient interface {
 	GetItemType(ctx context.Context, in *GetItemTypeRequest, opts ...grpc.CallOption) (*GetItemTypeResponse, error)
 	// get  item types by mservice_id
 	GetItemTypes(ctx context.Context, in *GetItemTypesRequest, opts ...grpc.CallOption) (*GetItemTypesResponse, error)
+	// allow a subarea type to be nested in a parent subarea type
+	AddSubareaTypeNesting(ctx context.Context, in *AddSubareaTypeNestingRequest, opts ...grpc.CallOption) (*AddSubareaTypeNestingResponse, error)
+	// remove a subarea type nesting rule
+	RemoveSubareaTypeNesting(ctx context.Context, in *RemoveSubareaTypeNestingRequest, opts ...grpc.CallOption) (*RemoveSubareaTypeNestingResponse, error)
+	// get all subarea type nesting rules by mservice_id
+	GetSubareaTypeNestings(ctx context.Context, in *GetSubareaTypeNestingsRequest, opts ...grpc.CallOption) (*GetSubareaTypeNestingsResponse, error)
+	// allow an item type to be stored in a subarea type
+	AddSubareaItemType(ctx context.Context, in *AddSubareaItemTypeRequest, opts ...grpc.CallOption) (*AddSubareaItemTypeResponse, error)
+	// remove a subarea item type rule
+	RemoveSubareaItemType(ctx context.Context, in *RemoveSubareaItemTypeRequest, opts ...grpc.CallOption) (*RemoveSubareaItemTypeResponse, error)
+	// get all subarea item type rules by mservice_id
+	GetSubareaItemTypes(ctx context.Context, in *GetSubareaItemTypesRequest, opts ...grpc.CallOption) (*GetSubareaItemTypesResponse, error)
 	// create a new subarea
 	CreateSubarea(ctx context.Context, in *CreateSubareaRequest, opts ...grpc.CallOption) (*CreateSubareaResponse, error)
 	// update an existing subarea
 	UpdateSubarea(ctx context.Context, in *UpdateSubareaRequest, opts ...grpc.CallOption) (*UpdateSubareaResponse, error)
 	// delete an existing subarea
 	DeleteSubarea(ctx context.Context, in *DeleteSubareaRequest, opts ...grpc.CallOption) (*DeleteSubareaResponse, error)
+	// restore a deleted subarea
+	UndeleteSubarea(ctx context.Context, in *UndeleteSubareaRequest, opts ...grpc.CallOption) (*UndeleteSubareaResponse, error)
 	// get a subarea by id
 	GetSubarea(ctx context.Context, in *GetSubareaRequest, opts ...grpc.CallOption) (*GetSubareaResponse, error)
 	// get all subareas by facility_id
 	GetSubareas(ctx context.Context, in *GetSubareasRequest, opts ...grpc.CallOption) (*GetSubareasResponse, error)
+	// get subareas by a list of ids
+	GetSubareasByIds(ctx context.Context, in *GetSubareasByIdsRequest, opts ...grpc.CallOption) (*GetSubareasByIdsResponse, error)
+	// stream all subareas by facility
+	StreamSubareas(ctx context.Context, in *StreamSubareasRequest, opts ...grpc.CallOption) (MServiceInventory_StreamSubareasClient, error)
+	// create a tree of subareas from a template
+	InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*InstantiateTemplateResponse, error)
+	// rewrite the positions of all children of a parent subarea
+	ReorderSubareas(ctx context.Context, in *ReorderSubareasRequest, opts ...grpc.CallOption) (*ReorderSubareasResponse, error)
 	// create a new product
 	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error)
 	// update an existing product
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
 	// delete an existing product
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	// restore a deleted product
+	UndeleteProduct(ctx context.Context, in *UndeleteProductRequest, opts ...grpc.CallOption) (*UndeleteProductResponse, error)
 	// get a product by id
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
 	// get all products by mservice_id
 	GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error)
+	// get products by a list of ids
+	GetProductsByIds(ctx context.Context, in *GetProductsByIdsRequest, opts ...grpc.CallOption) (*GetProductsByIdsResponse, error)
+	// stream all products by mservice_id
+	StreamProducts(ctx context.Context, in *StreamProductsRequest, opts ...grpc.CallOption) (MServiceInventory_StreamProductsClient, error)
+	// full text search of product name, sku and comment
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
 	// create a new inventory item
 	CreateInventoryItem(ctx context.Context, in *CreateInventoryItemRequest, opts ...grpc.CallOption) (*CreateInventoryItemResponse, error)
 	// update an existing inventory item
 	UpdateInventoryItem(ctx context.Context, in *UpdateInventoryItemRequest, opts ...grpc.CallOption) (*UpdateInventoryItemResponse, error)
 	// delete an existing inventory item
 	DeleteInventoryItem(ctx context.Context, in *DeleteInventoryItemRequest, opts ...grpc.CallOption) (*DeleteInventoryItemResponse, error)
+	// restore an deleted inventory item
+	UndeleteInventoryItem(ctx context.Context, in *UndeleteInventoryItemRequest, opts ...grpc.CallOption) (*UndeleteInventoryItemResponse, error)
 	// get an inventory item by id
 	GetInventoryItem(ctx context.Context, in *GetInventoryItemRequest, opts ...grpc.CallOption) (*GetInventoryItemResponse, error)
 	// get all inventory items for a product id
@@ -84,6 +120,14 @@ type MServiceInventoryClient interface {
 	GetInventoryItemsBySubarea(ctx context.Context, in *GetInventoryItemsBySubareaRequest, opts ...grpc.CallOption) (*GetInventoryItemsBySubareaResponse, error)
 	// get all inventory items in a facility
 	GetInventoryItemsByFacility(ctx context.Context, in *GetInventoryItemsByFacilityRequest, opts ...grpc.CallOption) (*GetInventoryItemsByFacilityResponse, error)
+	// get inventory items by a list of ids
+	GetInventoryItemsByIds(ctx context.Context, in *GetInventoryItemsByIdsRequest, opts ...grpc.CallOption) (*GetInventoryItemsByIdsResponse, error)
+	// stream all inventory items by facility
+	StreamInventoryItemsByFacility(ctx context.Context, in *StreamInventoryItemsByFacilityRequest, opts ...grpc.CallOption) (MServiceInventory_StreamInventoryItemsByFacilityClient, error)
+	// watch create, update and delete events for the inventory items of a facility, subarea subtree or product
+	WatchInventory(ctx context.Context, in *WatchInventoryRequest, opts ...grpc.CallOption) (MServiceInventory_WatchInventoryClient, error)
+	// get the facilities, subareas, products, items and types created, updated or deleted after a cursor
+	GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (*GetChangesSinceResponse, error)
 	// get current server version and uptime - health check
 	GetServerVersion(ctx context.Context, in *GetServerVersionRequest, opts ...grpc.CallOption) (*GetServerVersionResponse, error)
 	// create an entity schema
@@ -96,6 +140,14 @@ type MServiceInventoryClient interface {
 	GetEntitySchema(ctx context.Context, in *GetEntitySchemaRequest, opts ...grpc.CallOption) (*GetEntitySchemaResponse, error)
 	// get all entity schemas for account
 	GetEntitySchemas(ctx context.Context, in *GetEntitySchemasRequest, opts ...grpc.CallOption) (*GetEntitySchemasResponse, error)
+	// index a json_data extension field of an entity for filtering
+	CreateJsonIndex(ctx context.Context, in *CreateJsonIndexRequest, opts ...grpc.CallOption) (*CreateJsonIndexResponse, error)
+	// delete a json_data extension field index
+	DeleteJsonIndex(ctx context.Context, in *DeleteJsonIndexRequest, opts ...grpc.CallOption) (*DeleteJsonIndexResponse, error)
+	// get all json_data extension field indexes for account
+	GetJsonIndexes(ctx context.Context, in *GetJsonIndexesRequest, opts ...grpc.CallOption) (*GetJsonIndexesResponse, error)
+	// run an ordered list of create, update and delete operations in a single transaction
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
 }
 
 type mServiceInventoryClient struct {
@@ -133,6 +185,15 @@ func (c *mServiceInventoryClient) DeleteFacility(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *mServiceInventoryClient) UndeleteFacility(ctx context.Context, in *UndeleteFacilityRequest, opts ...grpc.CallOption) (*UndeleteFacilityResponse, error) {
+	out := new(UndeleteFacilityResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/undelete_facility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mServiceInventoryClient) GetFacility(ctx context.Context, in *GetFacilityRequest, opts ...grpc.CallOption) (*GetFacilityResponse, error) {
 	out := new(GetFacilityResponse)
 	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_facility", in, out, opts...)
@@ -160,6 +221,15 @@ func (c *mServiceInventoryClient) GetFacilityWrapper(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *mServiceInventoryClient) CloneFacility(ctx context.Context, in *CloneFacilityRequest, opts ...grpc.CallOption) (*CloneFacilityResponse, error) {
+	out := new(CloneFacilityResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/clone_facility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mServiceInventoryClient) CreateSubareaType(ctx context.Context, in *CreateSubareaTypeRequest, opts ...grpc.CallOption) (*CreateSubareaTypeResponse, error) {
 	out := new(CreateSubareaTypeResponse)
 	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/create_subarea_type", in, out, opts...)
@@ -250,6 +320,60 @@ func (c *mServiceInventoryClient) GetItemTypes(ctx context.Context, in *GetItemT
 	return out, nil
 }
 
+func (c *mServiceInventoryClient) AddSubareaTypeNesting(ctx context.Context, in *AddSubareaTypeNestingRequest, opts ...grpc.CallOption) (*AddSubareaTypeNestingResponse, error) {
+	out := new(AddSubareaTypeNestingResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/add_subarea_type_nesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) RemoveSubareaTypeNesting(ctx context.Context, in *RemoveSubareaTypeNestingRequest, opts ...grpc.CallOption) (*RemoveSubareaTypeNestingResponse, error) {
+	out := new(RemoveSubareaTypeNestingResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/remove_subarea_type_nesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) GetSubareaTypeNestings(ctx context.Context, in *GetSubareaTypeNestingsRequest, opts ...grpc.CallOption) (*GetSubareaTypeNestingsResponse, error) {
+	out := new(GetSubareaTypeNestingsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_subarea_type_nestings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) AddSubareaItemType(ctx context.Context, in *AddSubareaItemTypeRequest, opts ...grpc.CallOption) (*AddSubareaItemTypeResponse, error) {
+	out := new(AddSubareaItemTypeResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/add_subarea_item_type", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) RemoveSubareaItemType(ctx context.Context, in *RemoveSubareaItemTypeRequest, opts ...grpc.CallOption) (*RemoveSubareaItemTypeResponse, error) {
+	out := new(RemoveSubareaItemTypeResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/remove_subarea_item_type", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) GetSubareaItemTypes(ctx context.Context, in *GetSubareaItemTypesRequest, opts ...grpc.CallOption) (*GetSubareaItemTypesResponse, error) {
+	out := new(GetSubareaItemTypesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_subarea_item_types", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mServiceInventoryClient) CreateSubarea(ctx context.Context, in *CreateSubareaRequest, opts ...grpc.CallOption) (*CreateSubareaResponse, error) {
 	out := new(CreateSubareaResponse)
 	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/create_subarea", in, out, opts...)
@@ -277,6 +401,15 @@ func (c *mServiceInventoryClient) DeleteSubarea(ctx context.Context, in *DeleteS
 	return out, nil
 }
 
+func (c *mServiceInventoryClient) UndeleteSubarea(ctx context.Context, in *UndeleteSubareaRequest, opts ...grpc.CallOption) (*UndeleteSubareaResponse, error) {
+	out := new(UndeleteSubareaResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/undelete_subarea", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mServiceInventoryClient) GetSubarea(ctx context.Context, in *GetSubareaRequest, opts ...grpc.CallOption) (*GetSubareaResponse, error) {
 	out := new(GetSubareaResponse)
 	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_subarea", in, out, opts...)
@@ -295,6 +428,65 @@ func (c *mServiceInventoryClient) GetSubareas(ctx context.Context, in *GetSubare
 	return out, nil
 }
 
+func (c *mServiceInventoryClient) GetSubareasByIds(ctx context.Context, in *GetSubareasByIdsRequest, opts ...grpc.CallOption) (*GetSubareasByIdsResponse, error) {
+	out := new(GetSubareasByIdsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_subareas_by_ids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) StreamSubareas(ctx context.Context, in *StreamSubareasRequest, opts ...grpc.CallOption) (MServiceInventory_StreamSubareasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MServiceInventory_ServiceDesc.Streams[0], "/org.gaterace.mservice.inventory.MServiceInventory/stream_subareas", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mServiceInventoryStreamSubareasClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MServiceInventory_StreamSubareasClient interface {
+	Recv() (*StreamSubareasResponse, error)
+	grpc.ClientStream
+}
+
+type mServiceInventoryStreamSubareasClient struct {
+	grpc.ClientStream
+}
+
+func (x *mServiceInventoryStreamSubareasClient) Recv() (*StreamSubareasResponse, error) {
+	m := new(StreamSubareasResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mServiceInventoryClient) InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*InstantiateTemplateResponse, error) {
+	out := new(InstantiateTemplateResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/instantiate_template", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) ReorderSubareas(ctx context.Context, in *ReorderSubareasRequest, opts ...grpc.CallOption) (*ReorderSubareasResponse, error) {
+	out := new(ReorderSubareasResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/reorder_subareas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mServiceInventoryClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error) {
 	out := new(CreateProductResponse)
 	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/create_product", in, out, opts...)
@@ -322,6 +514,15 @@ func (c *mServiceInventoryClient) DeleteProduct(ctx context.Context, in *DeleteP
 	return out, nil
 }
 
+func (c *mServiceInventoryClient) UndeleteProduct(ctx context.Context, in *UndeleteProductRequest, opts ...grpc.CallOption) (*UndeleteProductResponse, error) {
+	out := new(UndeleteProductResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/undelete_product", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mServiceInventoryClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error) {
 	out := new(GetProductResponse)
 	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_product", in, out, opts...)
@@ -340,6 +541,56 @@ func (c *mServiceInventoryClient) GetProducts(ctx context.Context, in *GetProduc
 	return out, nil
 }
 
+func (c *mServiceInventoryClient) GetProductsByIds(ctx context.Context, in *GetProductsByIdsRequest, opts ...grpc.CallOption) (*GetProductsByIdsResponse, error) {
+	out := new(GetProductsByIdsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_products_by_ids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) StreamProducts(ctx context.Context, in *StreamProductsRequest, opts ...grpc.CallOption) (MServiceInventory_StreamProductsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MServiceInventory_ServiceDesc.Streams[1], "/org.gaterace.mservice.inventory.MServiceInventory/stream_products", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mServiceInventoryStreamProductsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MServiceInventory_StreamProductsClient interface {
+	Recv() (*StreamProductsResponse, error)
+	grpc.ClientStream
+}
+
+type mServiceInventoryStreamProductsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mServiceInventoryStreamProductsClient) Recv() (*StreamProductsResponse, error) {
+	m := new(StreamProductsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mServiceInventoryClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
+	out := new(SearchProductsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/search_products", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mServiceInventoryClient) CreateInventoryItem(ctx context.Context, in *CreateInventoryItemRequest, opts ...grpc.CallOption) (*CreateInventoryItemResponse, error) {
 	out := new(CreateInventoryItemResponse)
 	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/create_inventory_item", in, out, opts...)
@@ -367,6 +618,15 @@ func (c *mServiceInventoryClient) DeleteInventoryItem(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *mServiceInventoryClient) UndeleteInventoryItem(ctx context.Context, in *UndeleteInventoryItemRequest, opts ...grpc.CallOption) (*UndeleteInventoryItemResponse, error) {
+	out := new(UndeleteInventoryItemResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/undelete_inventory_item", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mServiceInventoryClient) GetInventoryItem(ctx context.Context, in *GetInventoryItemRequest, opts ...grpc.CallOption) (*GetInventoryItemResponse, error) {
 	out := new(GetInventoryItemResponse)
 	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_inventory_item", in, out, opts...)
@@ -403,6 +663,88 @@ func (c *mServiceInventoryClient) GetInventoryItemsByFacility(ctx context.Contex
 	return out, nil
 }
 
+func (c *mServiceInventoryClient) GetInventoryItemsByIds(ctx context.Context, in *GetInventoryItemsByIdsRequest, opts ...grpc.CallOption) (*GetInventoryItemsByIdsResponse, error) {
+	out := new(GetInventoryItemsByIdsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_inventory_items_by_ids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) StreamInventoryItemsByFacility(ctx context.Context, in *StreamInventoryItemsByFacilityRequest, opts ...grpc.CallOption) (MServiceInventory_StreamInventoryItemsByFacilityClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MServiceInventory_ServiceDesc.Streams[2], "/org.gaterace.mservice.inventory.MServiceInventory/stream_inventory_items_by_facility", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mServiceInventoryStreamInventoryItemsByFacilityClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MServiceInventory_StreamInventoryItemsByFacilityClient interface {
+	Recv() (*StreamInventoryItemsByFacilityResponse, error)
+	grpc.ClientStream
+}
+
+type mServiceInventoryStreamInventoryItemsByFacilityClient struct {
+	grpc.ClientStream
+}
+
+func (x *mServiceInventoryStreamInventoryItemsByFacilityClient) Recv() (*StreamInventoryItemsByFacilityResponse, error) {
+	m := new(StreamInventoryItemsByFacilityResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mServiceInventoryClient) WatchInventory(ctx context.Context, in *WatchInventoryRequest, opts ...grpc.CallOption) (MServiceInventory_WatchInventoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MServiceInventory_ServiceDesc.Streams[3], "/org.gaterace.mservice.inventory.MServiceInventory/watch_inventory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mServiceInventoryWatchInventoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MServiceInventory_WatchInventoryClient interface {
+	Recv() (*WatchInventoryResponse, error)
+	grpc.ClientStream
+}
+
+type mServiceInventoryWatchInventoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *mServiceInventoryWatchInventoryClient) Recv() (*WatchInventoryResponse, error) {
+	m := new(WatchInventoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mServiceInventoryClient) GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (*GetChangesSinceResponse, error) {
+	out := new(GetChangesSinceResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_changes_since", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mServiceInventoryClient) GetServerVersion(ctx context.Context, in *GetServerVersionRequest, opts ...grpc.CallOption) (*GetServerVersionResponse, error) {
 	out := new(GetServerVersionResponse)
 	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_server_version", in, out, opts...)
@@ -457,6 +799,42 @@ func (c *mServiceInventoryClient) GetEntitySchemas(ctx context.Context, in *GetE
 	return out, nil
 }
 
+func (c *mServiceInventoryClient) CreateJsonIndex(ctx context.Context, in *CreateJsonIndexRequest, opts ...grpc.CallOption) (*CreateJsonIndexResponse, error) {
+	out := new(CreateJsonIndexResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/create_json_index", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) DeleteJsonIndex(ctx context.Context, in *DeleteJsonIndexRequest, opts ...grpc.CallOption) (*DeleteJsonIndexResponse, error) {
+	out := new(DeleteJsonIndexResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/delete_json_index", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) GetJsonIndexes(ctx context.Context, in *GetJsonIndexesRequest, opts ...grpc.CallOption) (*GetJsonIndexesResponse, error) {
+	out := new(GetJsonIndexesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/get_json_indexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceInventoryClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.inventory.MServiceInventory/batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MServiceInventoryServer is the server API for MServiceInventory service.
 // All implementations must embed UnimplementedMServiceInventoryServer
 // for forward compatibility
@@ -467,12 +845,16 @@ type MServiceInventoryServer interface {
 	UpdateFacility(context.Context, *UpdateFacilityRequest) (*UpdateFacilityResponse, error)
 	// delete an existing facility
 	DeleteFacility(context.Context, *DeleteFacilityRequest) (*DeleteFacilityResponse, error)
+	// restore a deleted facility
+	UndeleteFacility(context.Context, *UndeleteFacilityRequest) (*UndeleteFacilityResponse, error)
 	// get a facility by id
 	GetFacility(context.Context, *GetFacilityRequest) (*GetFacilityResponse, error)
 	// get all facilities by mservice_id
 	GetFacilities(context.Context, *GetFacilitiesRequest) (*GetFacilitiesResponse, error)
 	// get a facility wrapper by id
 	GetFacilityWrapper(context.Context, *GetFacilityWrapperRequest) (*GetFacilityWrapperResponse, error)
+	// copy a facility and its subarea hierarchy to a new facility
+	CloneFacility(context.Context, *CloneFacilityRequest) (*CloneFacilityResponse, error)
 	// create new subarea type
 	CreateSubareaType(context.Context, *CreateSubareaTypeRequest) (*CreateSubareaTypeResponse, error)
 	// update an existing subarea type
@@ -493,32 +875,64 @@ type MServiceInventoryServer interface {
 	GetItemType(context.Context, *GetItemTypeRequest) (*GetItemTypeResponse, error)
 	// get  item types by mservice_id
 	GetItemTypes(context.Context, *GetItemTypesRequest) (*GetItemTypesResponse, error)
+	// allow a subarea type to be nested in a parent subarea type
+	AddSubareaTypeNesting(context.Context, *AddSubareaTypeNestingRequest) (*AddSubareaTypeNestingResponse, error)
+	// remove a subarea type nesting rule
+	RemoveSubareaTypeNesting(context.Context, *RemoveSubareaTypeNestingRequest) (*RemoveSubareaTypeNestingResponse, error)
+	// get all subarea type nesting rules by mservice_id
+	GetSubareaTypeNestings(context.Context, *GetSubareaTypeNestingsRequest) (*GetSubareaTypeNestingsResponse, error)
+	// allow an item type to be stored in a subarea type
+	AddSubareaItemType(context.Context, *AddSubareaItemTypeRequest) (*AddSubareaItemTypeResponse, error)
+	// remove a subarea item type rule
+	RemoveSubareaItemType(context.Context, *RemoveSubareaItemTypeRequest) (*RemoveSubareaItemTypeResponse, error)
+	// get all subarea item type rules by mservice_id
+	GetSubareaItemTypes(context.Context, *GetSubareaItemTypesRequest) (*GetSubareaItemTypesResponse, error)
 	// create a new subarea
 	CreateSubarea(context.Context, *CreateSubareaRequest) (*CreateSubareaResponse, error)
 	// update an existing subarea
 	UpdateSubarea(context.Context, *UpdateSubareaRequest) (*UpdateSubareaResponse, error)
 	// delete an existing subarea
 	DeleteSubarea(context.Context, *DeleteSubareaRequest) (*DeleteSubareaResponse, error)
+	// restore a deleted subarea
+	UndeleteSubarea(context.Context, *UndeleteSubareaRequest) (*UndeleteSubareaResponse, error)
 	// get a subarea by id
 	GetSubarea(context.Context, *GetSubareaRequest) (*GetSubareaResponse, error)
 	// get all subareas by facility_id
 	GetSubareas(context.Context, *GetSubareasRequest) (*GetSubareasResponse, error)
+	// get subareas by a list of ids
+	GetSubareasByIds(context.Context, *GetSubareasByIdsRequest) (*GetSubareasByIdsResponse, error)
+	// stream all subareas by facility
+	StreamSubareas(*StreamSubareasRequest, MServiceInventory_StreamSubareasServer) error
+	// create a tree of subareas from a template
+	InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*InstantiateTemplateResponse, error)
+	// rewrite the positions of all children of a parent subarea
+	ReorderSubareas(context.Context, *ReorderSubareasRequest) (*ReorderSubareasResponse, error)
 	// create a new product
 	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
 	// update an existing product
 	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
 	// delete an existing product
 	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	// restore a deleted product
+	UndeleteProduct(context.Context, *UndeleteProductRequest) (*UndeleteProductResponse, error)
 	// get a product by id
 	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
 	// get all products by mservice_id
 	GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error)
+	// get products by a list of ids
+	GetProductsByIds(context.Context, *GetProductsByIdsRequest) (*GetProductsByIdsResponse, error)
+	// stream all products by mservice_id
+	StreamProducts(*StreamProductsRequest, MServiceInventory_StreamProductsServer) error
+	// full text search of product name, sku and comment
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
 	// create a new inventory item
 	CreateInventoryItem(context.Context, *CreateInventoryItemRequest) (*CreateInventoryItemResponse, error)
 	// update an existing inventory item
 	UpdateInventoryItem(context.Context, *UpdateInventoryItemRequest) (*UpdateInventoryItemResponse, error)
 	// delete an existing inventory item
 	DeleteInventoryItem(context.Context, *DeleteInventoryItemRequest) (*DeleteInventoryItemResponse, error)
+	// restore an deleted inventory item
+	UndeleteInventoryItem(context.Context, *UndeleteInventoryItemRequest) (*UndeleteInventoryItemResponse, error)
 	// get an inventory item by id
 	GetInventoryItem(context.Context, *GetInventoryItemRequest) (*GetInventoryItemResponse, error)
 	// get all inventory items for a product id
@@ -527,6 +941,14 @@ type MServiceInventoryServer interface {
 	GetInventoryItemsBySubarea(context.Context, *GetInventoryItemsBySubareaRequest) (*GetInventoryItemsBySubareaResponse, error)
 	// get all inventory items in a facility
 	GetInventoryItemsByFacility(context.Context, *GetInventoryItemsByFacilityRequest) (*GetInventoryItemsByFacilityResponse, error)
+	// get inventory items by a list of ids
+	GetInventoryItemsByIds(context.Context, *GetInventoryItemsByIdsRequest) (*GetInventoryItemsByIdsResponse, error)
+	// stream all inventory items by facility
+	StreamInventoryItemsByFacility(*StreamInventoryItemsByFacilityRequest, MServiceInventory_StreamInventoryItemsByFacilityServer) error
+	// watch create, update and delete events for the inventory items of a facility, subarea subtree or product
+	WatchInventory(*WatchInventoryRequest, MServiceInventory_WatchInventoryServer) error
+	// get the facilities, subareas, products, items and types created, updated or deleted after a cursor
+	GetChangesSince(context.Context, *GetChangesSinceRequest) (*GetChangesSinceResponse, error)
 	// get current server version and uptime - health check
 	GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error)
 	// create an entity schema
@@ -539,6 +961,14 @@ type MServiceInventoryServer interface {
 	GetEntitySchema(context.Context, *GetEntitySchemaRequest) (*GetEntitySchemaResponse, error)
 	// get all entity schemas for account
 	GetEntitySchemas(context.Context, *GetEntitySchemasRequest) (*GetEntitySchemasResponse, error)
+	// index a json_data extension field of an entity for filtering
+	CreateJsonIndex(context.Context, *CreateJsonIndexRequest) (*CreateJsonIndexResponse, error)
+	// delete a json_data extension field index
+	DeleteJsonIndex(context.Context, *DeleteJsonIndexRequest) (*DeleteJsonIndexResponse, error)
+	// get all json_data extension field indexes for account
+	GetJsonIndexes(context.Context, *GetJsonIndexesRequest) (*GetJsonIndexesResponse, error)
+	// run an ordered list of create, update and delete operations in a single transaction
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
 	mustEmbedUnimplementedMServiceInventoryServer()
 }
 
@@ -555,6 +985,9 @@ func (UnimplementedMServiceInventoryServer) UpdateFacility(context.Context, *Upd
 func (UnimplementedMServiceInventoryServer) DeleteFacility(context.Context, *DeleteFacilityRequest) (*DeleteFacilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFacility not implemented")
 }
+func (UnimplementedMServiceInventoryServer) UndeleteFacility(context.Context, *UndeleteFacilityRequest) (*UndeleteFacilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteFacility not implemented")
+}
 func (UnimplementedMServiceInventoryServer) GetFacility(context.Context, *GetFacilityRequest) (*GetFacilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFacility not implemented")
 }
@@ -564,6 +997,9 @@ func (UnimplementedMServiceInventoryServer) GetFacilities(context.Context, *GetF
 func (UnimplementedMServiceInventoryServer) GetFacilityWrapper(context.Context, *GetFacilityWrapperRequest) (*GetFacilityWrapperResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFacilityWrapper not implemented")
 }
+func (UnimplementedMServiceInventoryServer) CloneFacility(context.Context, *CloneFacilityRequest) (*CloneFacilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneFacility not implemented")
+}
 func (UnimplementedMServiceInventoryServer) CreateSubareaType(context.Context, *CreateSubareaTypeRequest) (*CreateSubareaTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSubareaType not implemented")
 }
@@ -594,6 +1030,24 @@ func (UnimplementedMServiceInventoryServer) GetItemType(context.Context, *GetIte
 func (UnimplementedMServiceInventoryServer) GetItemTypes(context.Context, *GetItemTypesRequest) (*GetItemTypesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetItemTypes not implemented")
 }
+func (UnimplementedMServiceInventoryServer) AddSubareaTypeNesting(context.Context, *AddSubareaTypeNestingRequest) (*AddSubareaTypeNestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubareaTypeNesting not implemented")
+}
+func (UnimplementedMServiceInventoryServer) RemoveSubareaTypeNesting(context.Context, *RemoveSubareaTypeNestingRequest) (*RemoveSubareaTypeNestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSubareaTypeNesting not implemented")
+}
+func (UnimplementedMServiceInventoryServer) GetSubareaTypeNestings(context.Context, *GetSubareaTypeNestingsRequest) (*GetSubareaTypeNestingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubareaTypeNestings not implemented")
+}
+func (UnimplementedMServiceInventoryServer) AddSubareaItemType(context.Context, *AddSubareaItemTypeRequest) (*AddSubareaItemTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubareaItemType not implemented")
+}
+func (UnimplementedMServiceInventoryServer) RemoveSubareaItemType(context.Context, *RemoveSubareaItemTypeRequest) (*RemoveSubareaItemTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSubareaItemType not implemented")
+}
+func (UnimplementedMServiceInventoryServer) GetSubareaItemTypes(context.Context, *GetSubareaItemTypesRequest) (*GetSubareaItemTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubareaItemTypes not implemented")
+}
 func (UnimplementedMServiceInventoryServer) CreateSubarea(context.Context, *CreateSubareaRequest) (*CreateSubareaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSubarea not implemented")
 }
@@ -603,12 +1057,27 @@ func (UnimplementedMServiceInventoryServer) UpdateSubarea(context.Context, *Upda
 func (UnimplementedMServiceInventoryServer) DeleteSubarea(context.Context, *DeleteSubareaRequest) (*DeleteSubareaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubarea not implemented")
 }
+func (UnimplementedMServiceInventoryServer) UndeleteSubarea(context.Context, *UndeleteSubareaRequest) (*UndeleteSubareaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteSubarea not implemented")
+}
 func (UnimplementedMServiceInventoryServer) GetSubarea(context.Context, *GetSubareaRequest) (*GetSubareaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubarea not implemented")
 }
 func (UnimplementedMServiceInventoryServer) GetSubareas(context.Context, *GetSubareasRequest) (*GetSubareasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubareas not implemented")
 }
+func (UnimplementedMServiceInventoryServer) GetSubareasByIds(context.Context, *GetSubareasByIdsRequest) (*GetSubareasByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubareasByIds not implemented")
+}
+func (UnimplementedMServiceInventoryServer) StreamSubareas(*StreamSubareasRequest, MServiceInventory_StreamSubareasServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSubareas not implemented")
+}
+func (UnimplementedMServiceInventoryServer) InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*InstantiateTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateTemplate not implemented")
+}
+func (UnimplementedMServiceInventoryServer) ReorderSubareas(context.Context, *ReorderSubareasRequest) (*ReorderSubareasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderSubareas not implemented")
+}
 func (UnimplementedMServiceInventoryServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
 }
@@ -618,12 +1087,24 @@ func (UnimplementedMServiceInventoryServer) UpdateProduct(context.Context, *Upda
 func (UnimplementedMServiceInventoryServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
 }
+func (UnimplementedMServiceInventoryServer) UndeleteProduct(context.Context, *UndeleteProductRequest) (*UndeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteProduct not implemented")
+}
 func (UnimplementedMServiceInventoryServer) GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
 }
 func (UnimplementedMServiceInventoryServer) GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProducts not implemented")
 }
+func (UnimplementedMServiceInventoryServer) GetProductsByIds(context.Context, *GetProductsByIdsRequest) (*GetProductsByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductsByIds not implemented")
+}
+func (UnimplementedMServiceInventoryServer) StreamProducts(*StreamProductsRequest, MServiceInventory_StreamProductsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProducts not implemented")
+}
+func (UnimplementedMServiceInventoryServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
 func (UnimplementedMServiceInventoryServer) CreateInventoryItem(context.Context, *CreateInventoryItemRequest) (*CreateInventoryItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInventoryItem not implemented")
 }
@@ -633,6 +1114,9 @@ func (UnimplementedMServiceInventoryServer) UpdateInventoryItem(context.Context,
 func (UnimplementedMServiceInventoryServer) DeleteInventoryItem(context.Context, *DeleteInventoryItemRequest) (*DeleteInventoryItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInventoryItem not implemented")
 }
+func (UnimplementedMServiceInventoryServer) UndeleteInventoryItem(context.Context, *UndeleteInventoryItemRequest) (*UndeleteInventoryItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteInventoryItem not implemented")
+}
 func (UnimplementedMServiceInventoryServer) GetInventoryItem(context.Context, *GetInventoryItemRequest) (*GetInventoryItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryItem not implemented")
 }
@@ -645,6 +1129,18 @@ func (UnimplementedMServiceInventoryServer) GetInventoryItemsBySubarea(context.C
 func (UnimplementedMServiceInventoryServer) GetInventoryItemsByFacility(context.Context, *GetInventoryItemsByFacilityRequest) (*GetInventoryItemsByFacilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryItemsByFacility not implemented")
 }
+func (UnimplementedMServiceInventoryServer) GetInventoryItemsByIds(context.Context, *GetInventoryItemsByIdsRequest) (*GetInventoryItemsByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryItemsByIds not implemented")
+}
+func (UnimplementedMServiceInventoryServer) StreamInventoryItemsByFacility(*StreamInventoryItemsByFacilityRequest, MServiceInventory_StreamInventoryItemsByFacilityServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamInventoryItemsByFacility not implemented")
+}
+func (UnimplementedMServiceInventoryServer) WatchInventory(*WatchInventoryRequest, MServiceInventory_WatchInventoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInventory not implemented")
+}
+func (UnimplementedMServiceInventoryServer) GetChangesSince(context.Context, *GetChangesSinceRequest) (*GetChangesSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangesSince not implemented")
+}
 func (UnimplementedMServiceInventoryServer) GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServerVersion not implemented")
 }
@@ -663,6 +1159,18 @@ func (UnimplementedMServiceInventoryServer) GetEntitySchema(context.Context, *Ge
 func (UnimplementedMServiceInventoryServer) GetEntitySchemas(context.Context, *GetEntitySchemasRequest) (*GetEntitySchemasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEntitySchemas not implemented")
 }
+func (UnimplementedMServiceInventoryServer) CreateJsonIndex(context.Context, *CreateJsonIndexRequest) (*CreateJsonIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJsonIndex not implemented")
+}
+func (UnimplementedMServiceInventoryServer) DeleteJsonIndex(context.Context, *DeleteJsonIndexRequest) (*DeleteJsonIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJsonIndex not implemented")
+}
+func (UnimplementedMServiceInventoryServer) GetJsonIndexes(context.Context, *GetJsonIndexesRequest) (*GetJsonIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJsonIndexes not implemented")
+}
+func (UnimplementedMServiceInventoryServer) Batch(context.Context, *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
 func (UnimplementedMServiceInventoryServer) mustEmbedUnimplementedMServiceInventoryServer() {}
 
 // UnsafeMServiceInventoryServer may be embedded to opt out of forward compatibility for this service.
@@ -730,6 +1238,24 @@ func _MServiceInventory_DeleteFacility_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MServiceInventory_UndeleteFacility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteFacilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).UndeleteFacility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/undelete_facility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).UndeleteFacility(ctx, req.(*UndeleteFacilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MServiceInventory_GetFacility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFacilityRequest)
 	if err := dec(in); err != nil {
@@ -784,6 +1310,24 @@ func _MServiceInventory_GetFacilityWrapper_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MServiceInventory_CloneFacility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneFacilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).CloneFacility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/clone_facility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).CloneFacility(ctx, req.(*CloneFacilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MServiceInventory_CreateSubareaType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSubareaTypeRequest)
 	if err := dec(in); err != nil {
@@ -964,17 +1508,125 @@ func _MServiceInventory_GetItemTypes_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceInventory_CreateSubarea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSubareaRequest)
+func _MServiceInventory_AddSubareaTypeNesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSubareaTypeNestingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceInventoryServer).CreateSubarea(ctx, in)
+		return srv.(MServiceInventoryServer).AddSubareaTypeNesting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/create_subarea",
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/add_subarea_type_nesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).AddSubareaTypeNesting(ctx, req.(*AddSubareaTypeNestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_RemoveSubareaTypeNesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSubareaTypeNestingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).RemoveSubareaTypeNesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/remove_subarea_type_nesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).RemoveSubareaTypeNesting(ctx, req.(*RemoveSubareaTypeNestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_GetSubareaTypeNestings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubareaTypeNestingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).GetSubareaTypeNestings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/get_subarea_type_nestings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).GetSubareaTypeNestings(ctx, req.(*GetSubareaTypeNestingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_AddSubareaItemType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSubareaItemTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).AddSubareaItemType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/add_subarea_item_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).AddSubareaItemType(ctx, req.(*AddSubareaItemTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_RemoveSubareaItemType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSubareaItemTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).RemoveSubareaItemType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/remove_subarea_item_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).RemoveSubareaItemType(ctx, req.(*RemoveSubareaItemTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_GetSubareaItemTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubareaItemTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).GetSubareaItemTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/get_subarea_item_types",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).GetSubareaItemTypes(ctx, req.(*GetSubareaItemTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_CreateSubarea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubareaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).CreateSubarea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/create_subarea",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(MServiceInventoryServer).CreateSubarea(ctx, req.(*CreateSubareaRequest))
@@ -1018,6 +1670,24 @@ func _MServiceInventory_DeleteSubarea_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MServiceInventory_UndeleteSubarea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteSubareaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).UndeleteSubarea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/undelete_subarea",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).UndeleteSubarea(ctx, req.(*UndeleteSubareaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MServiceInventory_GetSubarea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSubareaRequest)
 	if err := dec(in); err != nil {
@@ -1054,6 +1724,81 @@ func _MServiceInventory_GetSubareas_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MServiceInventory_GetSubareasByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubareasByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).GetSubareasByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/get_subareas_by_ids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).GetSubareasByIds(ctx, req.(*GetSubareasByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_StreamSubareas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSubareasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MServiceInventoryServer).StreamSubareas(m, &mServiceInventoryStreamSubareasServer{stream})
+}
+
+type MServiceInventory_StreamSubareasServer interface {
+	Send(*StreamSubareasResponse) error
+	grpc.ServerStream
+}
+
+type mServiceInventoryStreamSubareasServer struct {
+	grpc.ServerStream
+}
+
+func (x *mServiceInventoryStreamSubareasServer) Send(m *StreamSubareasResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MServiceInventory_InstantiateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).InstantiateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/instantiate_template",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).InstantiateTemplate(ctx, req.(*InstantiateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_ReorderSubareas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderSubareasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).ReorderSubareas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/reorder_subareas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).ReorderSubareas(ctx, req.(*ReorderSubareasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MServiceInventory_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateProductRequest)
 	if err := dec(in); err != nil {
@@ -1108,6 +1853,24 @@ func _MServiceInventory_DeleteProduct_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MServiceInventory_UndeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).UndeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/undelete_product",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).UndeleteProduct(ctx, req.(*UndeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MServiceInventory_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProductRequest)
 	if err := dec(in); err != nil {
@@ -1144,6 +1907,63 @@ func _MServiceInventory_GetProducts_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MServiceInventory_GetProductsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).GetProductsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/get_products_by_ids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).GetProductsByIds(ctx, req.(*GetProductsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_StreamProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MServiceInventoryServer).StreamProducts(m, &mServiceInventoryStreamProductsServer{stream})
+}
+
+type MServiceInventory_StreamProductsServer interface {
+	Send(*StreamProductsResponse) error
+	grpc.ServerStream
+}
+
+type mServiceInventoryStreamProductsServer struct {
+	grpc.ServerStream
+}
+
+func (x *mServiceInventoryStreamProductsServer) Send(m *StreamProductsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MServiceInventory_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/search_products",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MServiceInventory_CreateInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateInventoryItemRequest)
 	if err := dec(in); err != nil {
@@ -1198,6 +2018,24 @@ func _MServiceInventory_DeleteInventoryItem_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MServiceInventory_UndeleteInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteInventoryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).UndeleteInventoryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/undelete_inventory_item",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).UndeleteInventoryItem(ctx, req.(*UndeleteInventoryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MServiceInventory_GetInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInventoryItemRequest)
 	if err := dec(in); err != nil {
@@ -1270,6 +2108,84 @@ func _MServiceInventory_GetInventoryItemsByFacility_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MServiceInventory_GetInventoryItemsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInventoryItemsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).GetInventoryItemsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/get_inventory_items_by_ids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).GetInventoryItemsByIds(ctx, req.(*GetInventoryItemsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_StreamInventoryItemsByFacility_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamInventoryItemsByFacilityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MServiceInventoryServer).StreamInventoryItemsByFacility(m, &mServiceInventoryStreamInventoryItemsByFacilityServer{stream})
+}
+
+type MServiceInventory_StreamInventoryItemsByFacilityServer interface {
+	Send(*StreamInventoryItemsByFacilityResponse) error
+	grpc.ServerStream
+}
+
+type mServiceInventoryStreamInventoryItemsByFacilityServer struct {
+	grpc.ServerStream
+}
+
+func (x *mServiceInventoryStreamInventoryItemsByFacilityServer) Send(m *StreamInventoryItemsByFacilityResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MServiceInventory_WatchInventory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInventoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MServiceInventoryServer).WatchInventory(m, &mServiceInventoryWatchInventoryServer{stream})
+}
+
+type MServiceInventory_WatchInventoryServer interface {
+	Send(*WatchInventoryResponse) error
+	grpc.ServerStream
+}
+
+type mServiceInventoryWatchInventoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *mServiceInventoryWatchInventoryServer) Send(m *WatchInventoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MServiceInventory_GetChangesSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangesSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).GetChangesSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/get_changes_since",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).GetChangesSince(ctx, req.(*GetChangesSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MServiceInventory_GetServerVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetServerVersionRequest)
 	if err := dec(in); err != nil {
@@ -1378,6 +2294,78 @@ func _MServiceInventory_GetEntitySchemas_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MServiceInventory_CreateJsonIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJsonIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).CreateJsonIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/create_json_index",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).CreateJsonIndex(ctx, req.(*CreateJsonIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_DeleteJsonIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJsonIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).DeleteJsonIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/delete_json_index",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).DeleteJsonIndex(ctx, req.(*DeleteJsonIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_GetJsonIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJsonIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).GetJsonIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/get_json_indexes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).GetJsonIndexes(ctx, req.(*GetJsonIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceInventory_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceInventoryServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.inventory.MServiceInventory/batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceInventoryServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MServiceInventory_ServiceDesc is the grpc.ServiceDesc for MServiceInventory service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1397,6 +2385,10 @@ var MServiceInventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "delete_facility",
 			Handler:    _MServiceInventory_DeleteFacility_Handler,
 		},
+		{
+			MethodName: "undelete_facility",
+			Handler:    _MServiceInventory_UndeleteFacility_Handler,
+		},
 		{
 			MethodName: "get_facility",
 			Handler:    _MServiceInventory_GetFacility_Handler,
@@ -1409,6 +2401,10 @@ var MServiceInventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "get_facility_wrapper",
 			Handler:    _MServiceInventory_GetFacilityWrapper_Handler,
 		},
+		{
+			MethodName: "clone_facility",
+			Handler:    _MServiceInventory_CloneFacility_Handler,
+		},
 		{
 			MethodName: "create_subarea_type",
 			Handler:    _MServiceInventory_CreateSubareaType_Handler,
@@ -1449,6 +2445,30 @@ var MServiceInventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "get_item_types",
 			Handler:    _MServiceInventory_GetItemTypes_Handler,
 		},
+		{
+			MethodName: "add_subarea_type_nesting",
+			Handler:    _MServiceInventory_AddSubareaTypeNesting_Handler,
+		},
+		{
+			MethodName: "remove_subarea_type_nesting",
+			Handler:    _MServiceInventory_RemoveSubareaTypeNesting_Handler,
+		},
+		{
+			MethodName: "get_subarea_type_nestings",
+			Handler:    _MServiceInventory_GetSubareaTypeNestings_Handler,
+		},
+		{
+			MethodName: "add_subarea_item_type",
+			Handler:    _MServiceInventory_AddSubareaItemType_Handler,
+		},
+		{
+			MethodName: "remove_subarea_item_type",
+			Handler:    _MServiceInventory_RemoveSubareaItemType_Handler,
+		},
+		{
+			MethodName: "get_subarea_item_types",
+			Handler:    _MServiceInventory_GetSubareaItemTypes_Handler,
+		},
 		{
 			MethodName: "create_subarea",
 			Handler:    _MServiceInventory_CreateSubarea_Handler,
@@ -1461,6 +2481,10 @@ var MServiceInventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "delete_subarea",
 			Handler:    _MServiceInventory_DeleteSubarea_Handler,
 		},
+		{
+			MethodName: "undelete_subarea",
+			Handler:    _MServiceInventory_UndeleteSubarea_Handler,
+		},
 		{
 			MethodName: "get_subarea",
 			Handler:    _MServiceInventory_GetSubarea_Handler,
@@ -1469,6 +2493,18 @@ var MServiceInventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "get_subareas",
 			Handler:    _MServiceInventory_GetSubareas_Handler,
 		},
+		{
+			MethodName: "get_subareas_by_ids",
+			Handler:    _MServiceInventory_GetSubareasByIds_Handler,
+		},
+		{
+			MethodName: "instantiate_template",
+			Handler:    _MServiceInventory_InstantiateTemplate_Handler,
+		},
+		{
+			MethodName: "reorder_subareas",
+			Handler:    _MServiceInventory_ReorderSubareas_Handler,
+		},
 		{
 			MethodName: "create_product",
 			Handler:    _MServiceInventory_CreateProduct_Handler,
@@ -1481,6 +2517,10 @@ var MServiceInventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "delete_product",
 			Handler:    _MServiceInventory_DeleteProduct_Handler,
 		},
+		{
+			MethodName: "undelete_product",
+			Handler:    _MServiceInventory_UndeleteProduct_Handler,
+		},
 		{
 			MethodName: "get_product",
 			Handler:    _MServiceInventory_GetProduct_Handler,
@@ -1489,6 +2529,14 @@ var MServiceInventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "get_products",
 			Handler:    _MServiceInventory_GetProducts_Handler,
 		},
+		{
+			MethodName: "get_products_by_ids",
+			Handler:    _MServiceInventory_GetProductsByIds_Handler,
+		},
+		{
+			MethodName: "search_products",
+			Handler:    _MServiceInventory_SearchProducts_Handler,
+		},
 		{
 			MethodName: "create_inventory_item",
 			Handler:    _MServiceInventory_CreateInventoryItem_Handler,
@@ -1501,6 +2549,10 @@ var MServiceInventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "delete_inventory_item",
 			Handler:    _MServiceInventory_DeleteInventoryItem_Handler,
 		},
+		{
+			MethodName: "undelete_inventory_item",
+			Handler:    _MServiceInventory_UndeleteInventoryItem_Handler,
+		},
 		{
 			MethodName: "get_inventory_item",
 			Handler:    _MServiceInventory_GetInventoryItem_Handler,
@@ -1517,6 +2569,14 @@ var MServiceInventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "get_inventory_items_by_facility",
 			Handler:    _MServiceInventory_GetInventoryItemsByFacility_Handler,
 		},
+		{
+			MethodName: "get_inventory_items_by_ids",
+			Handler:    _MServiceInventory_GetInventoryItemsByIds_Handler,
+		},
+		{
+			MethodName: "get_changes_since",
+			Handler:    _MServiceInventory_GetChangesSince_Handler,
+		},
 		{
 			MethodName: "get_server_version",
 			Handler:    _MServiceInventory_GetServerVersion_Handler,
@@ -1541,7 +2601,44 @@ var MServiceInventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "get_entity_schemas",
 			Handler:    _MServiceInventory_GetEntitySchemas_Handler,
 		},
+		{
+			MethodName: "create_json_index",
+			Handler:    _MServiceInventory_CreateJsonIndex_Handler,
+		},
+		{
+			MethodName: "delete_json_index",
+			Handler:    _MServiceInventory_DeleteJsonIndex_Handler,
+		},
+		{
+			MethodName: "get_json_indexes",
+			Handler:    _MServiceInventory_GetJsonIndexes_Handler,
+		},
+		{
+			MethodName: "batch",
+			Handler:    _MServiceInventory_Batch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "stream_subareas",
+			Handler:       _MServiceInventory_StreamSubareas_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "stream_products",
+			Handler:       _MServiceInventory_StreamProducts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "stream_inventory_items_by_facility",
+			Handler:       _MServiceInventory_StreamInventoryItemsByFacility_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "watch_inventory",
+			Handler:       _MServiceInventory_WatchInventory_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "MServiceInventory.proto",
 }
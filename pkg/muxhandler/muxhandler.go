@@ -16,14 +16,24 @@
 package muxhandler
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/gaterace/inventory/pkg/invauth"
 	pb "github.com/gaterace/inventory/pkg/mserviceinventory"
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"io/ioutil"
+	"sort"
 	"strconv"
+	"strings"
 
 	// "io/ioutil"
 	"net/http"
@@ -49,48 +59,89 @@ func (mh *muxHandler) AddRoutes() {
 	// TODO
 	mh.rtr.HandleFunc("/api/facility", mh.CreateFacilityHandler).Methods("POST")
 	mh.rtr.HandleFunc("/api/facility/{id:[0-9]+}", mh.UpdateFacilityHandler).Methods("PUT")
+	mh.rtr.HandleFunc("/api/facility/{id:[0-9]+}", mh.PatchFacilityHandler).Methods("PATCH")
+	mh.rtr.HandleFunc("/api/facility/{id:[0-9]+}", mh.DeleteFacilityHandler).Methods("DELETE")
 	mh.rtr.HandleFunc("/api/facility/{id:[0-9]+}/{version:[0-9]+}", mh.DeleteFacilityHandler).Methods("DELETE")
+	mh.rtr.HandleFunc("/api/facility/{id:[0-9]+}/{version:[0-9]+}/undelete", mh.UndeleteFacilityHandler).Methods("POST")
 	mh.rtr.HandleFunc("/api/facility/id/{id:[0-9]+}", mh.GetFacilityHandler).Methods("GET")
 	mh.rtr.HandleFunc("/api/facilities", mh.GetFacilitiesHandler).Methods("GET")
 	mh.rtr.HandleFunc("/api/facility/wrapper/{id:[0-9]+}", mh.GetFacilityWrapperHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/facility/{id:[0-9]+}/clone", mh.CloneFacilityHandler).Methods("POST")
 
 	mh.rtr.HandleFunc("/api/subareatype", mh.CreateSubareaTypeHandler).Methods("POST")
 	mh.rtr.HandleFunc("/api/subareatype/{id:[0-9]+}", mh.UpdateSubareaTypeHandler).Methods("PUT")
+	mh.rtr.HandleFunc("/api/subareatype/{id:[0-9]+}", mh.PatchSubareaTypeHandler).Methods("PATCH")
+	mh.rtr.HandleFunc("/api/subareatype/{id:[0-9]+}", mh.DeleteSubareaTypeHandler).Methods("DELETE")
 	mh.rtr.HandleFunc("/api/subareatype/{id:[0-9]+}/{version:[0-9]+}", mh.DeleteSubareaTypeHandler).Methods("DELETE")
 	mh.rtr.HandleFunc("/api/subareatype/id/{id:[0-9]+}", mh.GetSubareaTypeHandler).Methods("GET")
 	mh.rtr.HandleFunc("/api/subareatypes", mh.GetSubareasTypeHandler).Methods("GET")
 
 	mh.rtr.HandleFunc("/api/itemtype", mh.CreateItemTypeHandler).Methods("POST")
 	mh.rtr.HandleFunc("/api/itemtype/{id:[0-9]+}", mh.UpdateItemTypeHandler).Methods("PUT")
+	mh.rtr.HandleFunc("/api/itemtype/{id:[0-9]+}", mh.PatchItemTypeHandler).Methods("PATCH")
+	mh.rtr.HandleFunc("/api/itemtype/{id:[0-9]+}", mh.DeleteItemTypeHandler).Methods("DELETE")
 	mh.rtr.HandleFunc("/api/itemtype/{id:[0-9]+}/{version:[0-9]+}", mh.DeleteItemTypeHandler).Methods("DELETE")
 	mh.rtr.HandleFunc("/api/itemtype/id/{id:[0-9]+}", mh.GetItemTypeHandler).Methods("GET")
 	mh.rtr.HandleFunc("/api/itemtypes", mh.GetItemTypesHandler).Methods("GET")
 
+	mh.rtr.HandleFunc("/api/subareatype/nesting", mh.AddSubareaTypeNestingHandler).Methods("POST")
+	mh.rtr.HandleFunc("/api/subareatype/nesting/{parent:[0-9]+}/{child:[0-9]+}", mh.RemoveSubareaTypeNestingHandler).Methods("DELETE")
+	mh.rtr.HandleFunc("/api/subareatype/nestings", mh.GetSubareaTypeNestingsHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/subareatype/itemtype", mh.AddSubareaItemTypeHandler).Methods("POST")
+	mh.rtr.HandleFunc("/api/subareatype/itemtype/{subtype:[0-9]+}/{itemtype:[0-9]+}", mh.RemoveSubareaItemTypeHandler).Methods("DELETE")
+	mh.rtr.HandleFunc("/api/subareatype/itemtypes", mh.GetSubareaItemTypesHandler).Methods("GET")
+
 	mh.rtr.HandleFunc("/api/subarea", mh.CreateSubareaHandler).Methods("POST")
 	mh.rtr.HandleFunc("/api/subarea/{id:[0-9]+}", mh.UpdateSubareaHandler).Methods("PUT")
+	mh.rtr.HandleFunc("/api/subarea/{id:[0-9]+}", mh.PatchSubareaHandler).Methods("PATCH")
+	mh.rtr.HandleFunc("/api/subarea/{id:[0-9]+}", mh.DeleteSubareaHandler).Methods("DELETE")
 	mh.rtr.HandleFunc("/api/subarea/{id:[0-9]+}/{version:[0-9]+}", mh.DeleteSubareaHandler).Methods("DELETE")
+	mh.rtr.HandleFunc("/api/subarea/{id:[0-9]+}/{version:[0-9]+}/undelete", mh.UndeleteSubareaHandler).Methods("POST")
 	mh.rtr.HandleFunc("/api/subarea/id/{id:[0-9]+}", mh.GetSubareaHandler).Methods("GET")
 	mh.rtr.HandleFunc("/api/subareas/{facility:[0-9]+}", mh.GetSubareasHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/subareas/{facility:[0-9]+}/stream", mh.StreamSubareasHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/subareas/ids", mh.GetSubareasByIdsHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/subarea/template", mh.InstantiateTemplateHandler).Methods("POST")
+	mh.rtr.HandleFunc("/api/subarea/reorder", mh.ReorderSubareasHandler).Methods("POST")
 
 	mh.rtr.HandleFunc("/api/product", mh.CreateProductHandler).Methods("POST")
 	mh.rtr.HandleFunc("/api/product/{id:[0-9]+}", mh.UpdateProductHandler).Methods("PUT")
+	mh.rtr.HandleFunc("/api/product/{id:[0-9]+}", mh.PatchProductHandler).Methods("PATCH")
+	mh.rtr.HandleFunc("/api/product/{id:[0-9]+}", mh.DeleteProductHandler).Methods("DELETE")
 	mh.rtr.HandleFunc("/api/product/{id:[0-9]+}/{version:[0-9]+}", mh.DeleteProductHandler).Methods("DELETE")
+	mh.rtr.HandleFunc("/api/product/{id:[0-9]+}/{version:[0-9]+}/undelete", mh.UndeleteProductHandler).Methods("POST")
 	mh.rtr.HandleFunc("/api/product/id/{id:[0-9]+}", mh.GetProductHandler).Methods("GET")
 	mh.rtr.HandleFunc("/api/products", mh.GetProductsHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/products/stream", mh.StreamProductsHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/products/search", mh.SearchProductsHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/products/ids", mh.GetProductsByIdsHandler).Methods("GET")
 
 	mh.rtr.HandleFunc("/api/item", mh.CreateItemHandler).Methods("POST")
 	mh.rtr.HandleFunc("/api/item/{id:[0-9]+}", mh.UpdateItemHandler).Methods("PUT")
+	mh.rtr.HandleFunc("/api/item/{id:[0-9]+}", mh.PatchItemHandler).Methods("PATCH")
+	mh.rtr.HandleFunc("/api/item/{id:[0-9]+}", mh.DeleteItemHandler).Methods("DELETE")
 	mh.rtr.HandleFunc("/api/item/{id:[0-9]+}/{version:[0-9]+}", mh.DeleteItemHandler).Methods("DELETE")
+	mh.rtr.HandleFunc("/api/item/{id:[0-9]+}/{version:[0-9]+}/undelete", mh.UndeleteItemHandler).Methods("POST")
 	mh.rtr.HandleFunc("/api/item/id/{id:[0-9]+}", mh.GetItemHandler).Methods("GET")
 	mh.rtr.HandleFunc("/api/items/product/{id:[0-9]+}", mh.GetItemsByProductHandler).Methods("GET")
 	mh.rtr.HandleFunc("/api/items/subarea/{id:[0-9]+}", mh.GetItemsBySubareaHandler).Methods("GET")
 	mh.rtr.HandleFunc("/api/items/facility/{id:[0-9]+}", mh.GetItemsByFacilityHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/items/facility/{id:[0-9]+}/stream", mh.StreamItemsByFacilityHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/items/ids", mh.GetItemsByIdsHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/items/watch", mh.WatchInventoryHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/changes", mh.GetChangesSinceHandler).Methods("GET")
 
 	mh.rtr.HandleFunc("/api/schema", mh.CreateEntitySchemaHandler).Methods("POST")
 	mh.rtr.HandleFunc("/api/schema/{name}", mh.UpdateEntitySchemaHandler).Methods("PUT")
+	mh.rtr.HandleFunc("/api/schema/{name}", mh.PatchEntitySchemaHandler).Methods("PATCH")
+	mh.rtr.HandleFunc("/api/schema/{name}", mh.DeleteEntitySchemaHandler).Methods("DELETE")
 	mh.rtr.HandleFunc("/api/schema/{name}/{version:[0-9]+}", mh.DeleteEntitySchemaHandler).Methods("DELETE")
 	mh.rtr.HandleFunc("/api/schema/{name}", mh.EntitySchemaHandler).Methods("GET")
 	mh.rtr.HandleFunc("/api/schemas", mh.EntitySchemasHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/jsonindex", mh.CreateJsonIndexHandler).Methods("POST")
+	mh.rtr.HandleFunc("/api/jsonindex/{name}/{type}", mh.DeleteJsonIndexHandler).Methods("DELETE")
+	mh.rtr.HandleFunc("/api/jsonindexes", mh.JsonIndexesHandler).Methods("GET")
+	mh.rtr.HandleFunc("/api/batch", mh.BatchHandler).Methods("POST")
 
 	mh.rtr.HandleFunc("/api/server/version", mh.ServerVersionHandler).Methods("GET")
 }
@@ -140,9 +191,14 @@ func (mh *muxHandler) UpdateFacilityHandler(w http.ResponseWriter, r *http.Reque
 	req.FacilityId = facilityId
 
 	ctx := getTokenContext(r)
+	current := mh.currentFacility(ctx, req.GetFacilityId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
 	resp, err := mh.auth.UpdateFacility(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
 		return
 	}
 
@@ -152,6 +208,51 @@ func (mh *muxHandler) UpdateFacilityHandler(w http.ResponseWriter, r *http.Reque
 
 }
 
+// Handle a JSON Merge Patch of a facility. Expects a PATCH request and a body with the version and the fields to change.
+func (mh *muxHandler) PatchFacilityHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	facilityId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.UpdateFacilityRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	ctx := getTokenContext(r)
+	current := mh.currentFacility(ctx, facilityId)
+	base := &patchBase{current: current}
+	currentJsonData := func() string {
+		resp, _, found := base.get()
+		if !found {
+			return ""
+		}
+
+		return resp.(*pb.GetFacilityResponse).GetFacility().GetJsonData()
+	}
+
+	req.UpdateMask, err = mergePatchRequest(buf, &req, "facility_id", currentJsonData)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.FacilityId = facilityId
+	if !checkPatchBase(&req.Version, base, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.UpdateFacility(ctx, &req)
+	if err == nil {
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
 func (mh *muxHandler) DeleteFacilityHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	facilityId, _ := strconv.ParseInt(vars["id"], 10, 64)
@@ -162,9 +263,14 @@ func (mh *muxHandler) DeleteFacilityHandler(w http.ResponseWriter, r *http.Reque
 	req.Version = int32(version)
 
 	ctx := getTokenContext(r)
+	current := mh.currentFacility(ctx, req.GetFacilityId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
 	resp, err := mh.auth.DeleteFacility(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), 0, current, r, w)
 		return
 	}
 
@@ -174,15 +280,44 @@ func (mh *muxHandler) DeleteFacilityHandler(w http.ResponseWriter, r *http.Reque
 
 }
 
+// Handle UndeleteFacility. Expects a POST request and nil body.
+func (mh *muxHandler) UndeleteFacilityHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, _ := strconv.ParseInt(vars["id"], 10, 64)
+	version, _ := strconv.ParseInt(vars["version"], 10, 32)
+
+	req := pb.UndeleteFacilityRequest{}
+	req.FacilityId = id
+	req.Version = int32(version)
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.UndeleteFacility(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
 func (mh *muxHandler) GetFacilityHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	facilityId, _ := strconv.ParseInt(vars["id"], 10, 64)
 	req := pb.GetFacilityRequest{}
 	req.FacilityId = facilityId
+	includeDeleted, err := getIncludeDeleted(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.IncludeDeleted = includeDeleted
 	ctx := getTokenContext(r)
 	resp, err := mh.auth.GetFacility(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeGetResponse(resp, err, int(resp.GetErrorCode()), resp.GetFacility().GetVersion(), r, w)
 		return
 	}
 
@@ -208,8 +343,53 @@ func (mh *muxHandler) GetFacilityWrapperHandler(w http.ResponseWriter, r *http.R
 	return
 }
 
+func (mh *muxHandler) CloneFacilityHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.CloneFacilityRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	err = json.Unmarshal(buf, &req)
+	if err != nil {
+		w.WriteHeader(502)
+		return
+	}
+
+	req.FacilityId = id
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.CloneFacility(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
 func (mh *muxHandler) GetFacilitiesHandler(w http.ResponseWriter, r *http.Request) {
 	req := pb.GetFacilitiesRequest{}
+	pageSize, pageToken, totalCount, err := getPageParams(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.PageSize = pageSize
+	req.PageToken = pageToken
+	req.IncludeTotalCount = totalCount
+	req.IncludeDeleted, err = getIncludeDeleted(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
 	ctx := getTokenContext(r)
 	resp, err := mh.auth.GetFacilities(ctx, &req)
 	if err == nil {
@@ -268,9 +448,49 @@ func (mh *muxHandler) UpdateSubareaTypeHandler(w http.ResponseWriter, r *http.Re
 
 	ctx := getTokenContext(r)
 
+	current := mh.currentSubareaType(ctx, req.GetSubareaTypeId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.UpdateSubareaType(ctx, &req)
+	if err == nil {
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+// Handle a JSON Merge Patch of a subarea type. Expects a PATCH request and a body with the version and the fields to change.
+func (mh *muxHandler) PatchSubareaTypeHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	typeId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.UpdateSubareaTypeRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	ctx := getTokenContext(r)
+	req.UpdateMask, err = mergePatchRequest(buf, &req, "subarea_type_id", nil)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.SubareaTypeId = int32(typeId)
+	current := mh.currentSubareaType(ctx, req.GetSubareaTypeId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
 	resp, err := mh.auth.UpdateSubareaType(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
 		return
 	}
 
@@ -288,10 +508,23 @@ func (mh *muxHandler) DeleteSubareaTypeHandler(w http.ResponseWriter, r *http.Re
 	req.SubareaTypeId = int32(typeId)
 	req.Version = int32(version)
 
+	reassignTo, err := getReassignTo(r, 32)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.ReassignTo = int32(reassignTo)
+
 	ctx := getTokenContext(r)
+	current := mh.currentSubareaType(ctx, req.GetSubareaTypeId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
 	resp, err := mh.auth.DeleteSubareaType(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), 0, current, r, w)
 		return
 	}
 
@@ -308,7 +541,7 @@ func (mh *muxHandler) GetSubareaTypeHandler(w http.ResponseWriter, r *http.Reque
 	ctx := getTokenContext(r)
 	resp, err := mh.auth.GetSubareaType(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeGetResponse(resp, err, int(resp.GetErrorCode()), resp.GetSubareaType().GetVersion(), r, w)
 		return
 	}
 
@@ -378,9 +611,49 @@ func (mh *muxHandler) UpdateItemTypeHandler(w http.ResponseWriter, r *http.Reque
 
 	ctx := getTokenContext(r)
 
+	current := mh.currentItemType(ctx, req.GetItemTypeId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
 	resp, err := mh.auth.UpdateItemType(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+// Handle a JSON Merge Patch of an item type. Expects a PATCH request and a body with the version and the fields to change.
+func (mh *muxHandler) PatchItemTypeHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	typeId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.UpdateItemTypeRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	ctx := getTokenContext(r)
+	req.UpdateMask, err = mergePatchRequest(buf, &req, "item_type_id", nil)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.ItemTypeId = int32(typeId)
+	current := mh.currentItemType(ctx, req.GetItemTypeId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.UpdateItemType(ctx, &req)
+	if err == nil {
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
 		return
 	}
 
@@ -398,10 +671,23 @@ func (mh *muxHandler) DeleteItemTypeHandler(w http.ResponseWriter, r *http.Reque
 	req.ItemTypeId = int32(typeId)
 	req.Version = int32(version)
 
+	reassignTo, err := getReassignTo(r, 32)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.ReassignTo = int32(reassignTo)
+
 	ctx := getTokenContext(r)
+	current := mh.currentItemType(ctx, req.GetItemTypeId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
 	resp, err := mh.auth.DeleteItemType(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), 0, current, r, w)
 		return
 	}
 
@@ -418,7 +704,7 @@ func (mh *muxHandler) GetItemTypeHandler(w http.ResponseWriter, r *http.Request)
 	ctx := getTokenContext(r)
 	resp, err := mh.auth.GetItemType(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeGetResponse(resp, err, int(resp.GetErrorCode()), resp.GetItemType().GetVersion(), r, w)
 		return
 	}
 
@@ -441,8 +727,8 @@ func (mh *muxHandler) GetItemTypesHandler(w http.ResponseWriter, r *http.Request
 	return
 }
 
-func (mh *muxHandler) CreateSubareaHandler(w http.ResponseWriter, r *http.Request) {
-	req := pb.CreateSubareaRequest{}
+func (mh *muxHandler) AddSubareaTypeNestingHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.AddSubareaTypeNestingRequest{}
 	buf, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		w.WriteHeader(501)
@@ -456,7 +742,7 @@ func (mh *muxHandler) CreateSubareaHandler(w http.ResponseWriter, r *http.Reques
 	}
 
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.CreateSubarea(ctx, &req)
+	resp, err := mh.auth.AddSubareaTypeNesting(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -467,27 +753,32 @@ func (mh *muxHandler) CreateSubareaHandler(w http.ResponseWriter, r *http.Reques
 	return
 }
 
-func (mh *muxHandler) UpdateSubareaHandler(w http.ResponseWriter, r *http.Request) {
+func (mh *muxHandler) RemoveSubareaTypeNestingHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	subareaId, _ := strconv.ParseInt(vars["id"], 10, 64)
-	req := pb.UpdateSubareaRequest{}
-	buf, err := ioutil.ReadAll(r.Body)
-	if err != nil {
-		w.WriteHeader(501)
-		return
-	}
+	parent, _ := strconv.ParseInt(vars["parent"], 10, 32)
+	child, _ := strconv.ParseInt(vars["child"], 10, 32)
 
-	err = json.Unmarshal(buf, &req)
-	if err != nil {
-		w.WriteHeader(502)
+	req := pb.RemoveSubareaTypeNestingRequest{}
+	req.ParentSubareaTypeId = int32(parent)
+	req.ChildSubareaTypeId = int32(child)
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.RemoveSubareaTypeNesting(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
 	}
 
-	req.SubareaId = subareaId
+	w.WriteHeader(503)
 
-	ctx := getTokenContext(r)
+	return
+}
 
-	resp, err := mh.auth.UpdateSubarea(ctx, &req)
+func (mh *muxHandler) GetSubareaTypeNestingsHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.GetSubareaTypeNestingsRequest{}
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetSubareaTypeNestings(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -498,17 +789,22 @@ func (mh *muxHandler) UpdateSubareaHandler(w http.ResponseWriter, r *http.Reques
 	return
 }
 
-func (mh *muxHandler) DeleteSubareaHandler(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	subareaId, _ := strconv.ParseInt(vars["id"], 10, 64)
-	version, _ := strconv.ParseInt(vars["version"], 10, 32)
+func (mh *muxHandler) AddSubareaItemTypeHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.AddSubareaItemTypeRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
 
-	req := pb.DeleteSubareaRequest{}
-	req.SubareaId = subareaId
-	req.Version = int32(version)
+	err = json.Unmarshal(buf, &req)
+	if err != nil {
+		w.WriteHeader(502)
+		return
+	}
 
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.DeleteSubarea(ctx, &req)
+	resp, err := mh.auth.AddSubareaItemType(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -519,14 +815,17 @@ func (mh *muxHandler) DeleteSubareaHandler(w http.ResponseWriter, r *http.Reques
 	return
 }
 
-func (mh *muxHandler) GetSubareaHandler(w http.ResponseWriter, r *http.Request) {
+func (mh *muxHandler) RemoveSubareaItemTypeHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	subareaId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	subtype, _ := strconv.ParseInt(vars["subtype"], 10, 32)
+	itemtype, _ := strconv.ParseInt(vars["itemtype"], 10, 32)
+
+	req := pb.RemoveSubareaItemTypeRequest{}
+	req.SubareaTypeId = int32(subtype)
+	req.ItemTypeId = int32(itemtype)
 
-	req := pb.GetSubareaRequest{}
-	req.SubareaId = subareaId
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.GetSubarea(ctx, &req)
+	resp, err := mh.auth.RemoveSubareaItemType(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -535,16 +834,13 @@ func (mh *muxHandler) GetSubareaHandler(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(503)
 
 	return
-
 }
 
-func (mh *muxHandler) GetSubareasHandler(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	facilityId, _ := strconv.ParseInt(vars["facility"], 10, 64)
-	req := pb.GetSubareasRequest{}
-	req.FacilityId = facilityId
+func (mh *muxHandler) GetSubareaItemTypesHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.GetSubareaItemTypesRequest{}
+
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.GetSubareas(ctx, &req)
+	resp, err := mh.auth.GetSubareaItemTypes(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -555,8 +851,8 @@ func (mh *muxHandler) GetSubareasHandler(w http.ResponseWriter, r *http.Request)
 	return
 }
 
-func (mh *muxHandler) CreateProductHandler(w http.ResponseWriter, r *http.Request) {
-	req := pb.CreateProductRequest{}
+func (mh *muxHandler) CreateSubareaHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.CreateSubareaRequest{}
 	buf, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		w.WriteHeader(501)
@@ -570,7 +866,7 @@ func (mh *muxHandler) CreateProductHandler(w http.ResponseWriter, r *http.Reques
 	}
 
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.CreateProduct(ctx, &req)
+	resp, err := mh.auth.CreateSubarea(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -581,10 +877,10 @@ func (mh *muxHandler) CreateProductHandler(w http.ResponseWriter, r *http.Reques
 	return
 }
 
-func (mh *muxHandler) UpdateProductHandler(w http.ResponseWriter, r *http.Request) {
+func (mh *muxHandler) UpdateSubareaHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	productId, _ := strconv.ParseInt(vars["id"], 10, 64)
-	req := pb.UpdateProductRequest{}
+	subareaId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.UpdateSubareaRequest{}
 	buf, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		w.WriteHeader(501)
@@ -597,31 +893,63 @@ func (mh *muxHandler) UpdateProductHandler(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	req.ProductId = productId
+	req.SubareaId = subareaId
+
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.UpdateProduct(ctx, &req)
-	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+
+	current := mh.currentSubarea(ctx, req.GetSubareaId())
+	if !checkIfMatch(&req.Version, current, r, w) {
 		return
 	}
 
-	w.WriteHeader(503)
+	resp, err := mh.auth.UpdateSubarea(ctx, &req)
+	if err == nil {
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
+		return
+	}
+
+	w.WriteHeader(503)
 
 	return
 }
 
-func (mh *muxHandler) DeleteProductHandler(w http.ResponseWriter, r *http.Request) {
+// Handle a JSON Merge Patch of a subarea. Expects a PATCH request and a body with the version and the fields to change.
+func (mh *muxHandler) PatchSubareaHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	productId, _ := strconv.ParseInt(vars["id"], 10, 64)
-	version, _ := strconv.ParseInt(vars["version"], 10, 32)
+	subareaId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.UpdateSubareaRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
 
-	req := pb.DeleteProductRequest{}
-	req.ProductId = productId
-	req.Version = int32(version)
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.DeleteProduct(ctx, &req)
+	current := mh.currentSubarea(ctx, subareaId)
+	base := &patchBase{current: current}
+	currentJsonData := func() string {
+		resp, _, found := base.get()
+		if !found {
+			return ""
+		}
+
+		return resp.(*pb.GetSubareaResponse).GetSubarea().GetJsonData()
+	}
+
+	req.UpdateMask, err = mergePatchRequest(buf, &req, "subarea_id", currentJsonData)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.SubareaId = subareaId
+	if !checkPatchBase(&req.Version, base, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.UpdateSubarea(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
 		return
 	}
 
@@ -630,15 +958,24 @@ func (mh *muxHandler) DeleteProductHandler(w http.ResponseWriter, r *http.Reques
 	return
 }
 
-func (mh *muxHandler) GetProductHandler(w http.ResponseWriter, r *http.Request) {
+func (mh *muxHandler) DeleteSubareaHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	productId, _ := strconv.ParseInt(vars["id"], 10, 64)
-	req := pb.GetProductRequest{}
-	req.ProductId = productId
+	subareaId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	version, _ := strconv.ParseInt(vars["version"], 10, 32)
+
+	req := pb.DeleteSubareaRequest{}
+	req.SubareaId = subareaId
+	req.Version = int32(version)
+
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.GetProduct(ctx, &req)
+	current := mh.currentSubarea(ctx, req.GetSubareaId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.DeleteSubarea(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), 0, current, r, w)
 		return
 	}
 
@@ -647,10 +984,18 @@ func (mh *muxHandler) GetProductHandler(w http.ResponseWriter, r *http.Request)
 	return
 }
 
-func (mh *muxHandler) GetProductsHandler(w http.ResponseWriter, r *http.Request) {
-	req := pb.GetProductsRequest{}
+// Handle UndeleteSubarea. Expects a POST request and nil body.
+func (mh *muxHandler) UndeleteSubareaHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, _ := strconv.ParseInt(vars["id"], 10, 64)
+	version, _ := strconv.ParseInt(vars["version"], 10, 32)
+
+	req := pb.UndeleteSubareaRequest{}
+	req.SubareaId = id
+	req.Version = int32(version)
+
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.GetProducts(ctx, &req)
+	resp, err := mh.auth.UndeleteSubarea(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -661,52 +1006,54 @@ func (mh *muxHandler) GetProductsHandler(w http.ResponseWriter, r *http.Request)
 	return
 }
 
-func (mh *muxHandler) CreateItemHandler(w http.ResponseWriter, r *http.Request) {
-	req := pb.CreateInventoryItemRequest{}
-	buf, err := ioutil.ReadAll(r.Body)
-	if err != nil {
-		w.WriteHeader(501)
-		return
-	}
+func (mh *muxHandler) GetSubareaHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	subareaId, _ := strconv.ParseInt(vars["id"], 10, 64)
 
-	err = json.Unmarshal(buf, &req)
+	req := pb.GetSubareaRequest{}
+	req.SubareaId = subareaId
+	includeDeleted, err := getIncludeDeleted(r)
 	if err != nil {
-		w.WriteHeader(502)
+		w.WriteHeader(400)
 		return
 	}
 
+	req.IncludeDeleted = includeDeleted
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.CreateInventoryItem(ctx, &req)
+	resp, err := mh.auth.GetSubarea(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeGetResponse(resp, err, int(resp.GetErrorCode()), resp.GetSubarea().GetVersion(), r, w)
 		return
 	}
 
 	w.WriteHeader(503)
 
 	return
+
 }
 
-func (mh *muxHandler) UpdateItemHandler(w http.ResponseWriter, r *http.Request) {
+func (mh *muxHandler) GetSubareasHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	itemId, _ := strconv.ParseInt(vars["id"], 10, 64)
-	req := pb.UpdateInventoryItemRequest{}
-	buf, err := ioutil.ReadAll(r.Body)
+	facilityId, _ := strconv.ParseInt(vars["facility"], 10, 64)
+	req := pb.GetSubareasRequest{}
+	req.FacilityId = facilityId
+	pageSize, pageToken, totalCount, err := getPageParams(r)
 	if err != nil {
-		w.WriteHeader(501)
+		w.WriteHeader(400)
 		return
 	}
 
-	err = json.Unmarshal(buf, &req)
+	req.PageSize = pageSize
+	req.PageToken = pageToken
+	req.IncludeTotalCount = totalCount
+	req.IncludeDeleted, err = getIncludeDeleted(r)
 	if err != nil {
-		w.WriteHeader(502)
+		w.WriteHeader(400)
 		return
 	}
 
-	req.InventoryItemId = itemId
-
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.UpdateInventoryItem(ctx, &req)
+	resp, err := mh.auth.GetSubareas(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -717,33 +1064,31 @@ func (mh *muxHandler) UpdateItemHandler(w http.ResponseWriter, r *http.Request)
 	return
 }
 
-func (mh *muxHandler) DeleteItemHandler(w http.ResponseWriter, r *http.Request) {
+// Handle StreamSubareas, writing each subarea as a line of newline delimited json.
+func (mh *muxHandler) StreamSubareasHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	itemId, _ := strconv.ParseInt(vars["id"], 10, 64)
-	version, _ := strconv.ParseInt(vars["version"], 10, 32)
-	req := pb.DeleteInventoryItemRequest{}
-	req.InventoryItemId = itemId
-	req.Version = int32(version)
-	ctx := getTokenContext(r)
-	resp, err := mh.auth.DeleteInventoryItem(ctx, &req)
-	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
-		return
-	}
+	facilityId, _ := strconv.ParseInt(vars["facility"], 10, 64)
+	req := pb.StreamSubareasRequest{}
+	req.FacilityId = facilityId
 
-	w.WriteHeader(503)
+	stream := &subareaStream{ndjsonStream{ctx: getStreamTokenContext(r), w: w}}
+	err := mh.auth.StreamSubareas(&req, stream)
+	stream.finish(err)
+}
 
-	return
+// Handle GetSubareasByIds, taking the ids as a comma separated ids query parameter.
+func (mh *muxHandler) GetSubareasByIdsHandler(w http.ResponseWriter, r *http.Request) {
+	ids, err := getIdsParam(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
 
-}
+	req := pb.GetSubareasByIdsRequest{}
+	req.SubareaIds = ids
 
-func (mh *muxHandler) GetItemHandler(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	itemId, _ := strconv.ParseInt(vars["id"], 10, 64)
-	req := pb.GetInventoryItemRequest{}
-	req.InventoryItemId = itemId
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.GetInventoryItem(ctx, &req)
+	resp, err := mh.auth.GetSubareasByIds(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -754,30 +1099,22 @@ func (mh *muxHandler) GetItemHandler(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
-func (mh *muxHandler) GetItemsByProductHandler(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	productId, _ := strconv.ParseInt(vars["id"], 10, 64)
-	req := pb.GetInventoryItemsByProductRequest{}
-	req.ProductId = productId
-	ctx := getTokenContext(r)
-	resp, err := mh.auth.GetInventoryItemsByProduct(ctx, &req)
-	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+func (mh *muxHandler) InstantiateTemplateHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.InstantiateTemplateRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
 		return
 	}
 
-	w.WriteHeader(503)
-
-	return
-}
+	err = json.Unmarshal(buf, &req)
+	if err != nil {
+		w.WriteHeader(502)
+		return
+	}
 
-func (mh *muxHandler) GetItemsBySubareaHandler(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	subareaId, _ := strconv.ParseInt(vars["id"], 10, 64)
-	req := pb.GetInventoryItemsBySubareaRequest{}
-	req.SubareaId = subareaId
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.GetInventoryItemsBySubarea(ctx, &req)
+	resp, err := mh.auth.InstantiateTemplate(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -788,13 +1125,22 @@ func (mh *muxHandler) GetItemsBySubareaHandler(w http.ResponseWriter, r *http.Re
 	return
 }
 
-func (mh *muxHandler) GetItemsByFacilityHandler(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	facilityId, _ := strconv.ParseInt(vars["id"], 10, 64)
-	req := pb.GetInventoryItemsByFacilityRequest{}
-	req.FacilityId = facilityId
+func (mh *muxHandler) ReorderSubareasHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.ReorderSubareasRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	err = json.Unmarshal(buf, &req)
+	if err != nil {
+		w.WriteHeader(502)
+		return
+	}
+
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.GetInventoryItemsByFacility(ctx, &req)
+	resp, err := mh.auth.ReorderSubareas(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -805,9 +1151,8 @@ func (mh *muxHandler) GetItemsByFacilityHandler(w http.ResponseWriter, r *http.R
 	return
 }
 
-// Handle CreateEntitySchema. Expects a POST request and CreateEntitySchemaRequest body.
-func (mh *muxHandler) CreateEntitySchemaHandler(w http.ResponseWriter, r *http.Request) {
-	req := pb.CreateEntitySchemaRequest{}
+func (mh *muxHandler) CreateProductHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.CreateProductRequest{}
 	buf, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		w.WriteHeader(501)
@@ -821,8 +1166,7 @@ func (mh *muxHandler) CreateEntitySchemaHandler(w http.ResponseWriter, r *http.R
 	}
 
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.CreateEntitySchema(ctx, &req)
-
+	resp, err := mh.auth.CreateProduct(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -831,14 +1175,12 @@ func (mh *muxHandler) CreateEntitySchemaHandler(w http.ResponseWriter, r *http.R
 	w.WriteHeader(503)
 
 	return
-
 }
 
-// Handle UpdateEntitySchema. Expects a PUT request and CreateEntitySchemaRequest body.
-func (mh *muxHandler) UpdateEntitySchemaHandler(w http.ResponseWriter, r *http.Request) {
+func (mh *muxHandler) UpdateProductHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	entityName, _ := vars["name"]
-	req := pb.UpdateEntitySchemaRequest{}
+	productId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.UpdateProductRequest{}
 	buf, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		w.WriteHeader(501)
@@ -851,71 +1193,115 @@ func (mh *muxHandler) UpdateEntitySchemaHandler(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	req.EntityName = entityName
-
+	req.ProductId = productId
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.UpdateEntitySchema(ctx, &req)
+	current := mh.currentProduct(ctx, req.GetProductId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
 
+	resp, err := mh.auth.UpdateProduct(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
 		return
 	}
 
 	w.WriteHeader(503)
 
 	return
-
 }
 
-// Handle DeleteEntitySchema. Expects a DELETE request and nil body.
-func (mh *muxHandler) DeleteEntitySchemaHandler(w http.ResponseWriter, r *http.Request) {
+// Handle a JSON Merge Patch of a product. Expects a PATCH request and a body with the version and the fields to change.
+func (mh *muxHandler) PatchProductHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	entityName, _ := vars["name"]
-	version, _ := strconv.ParseInt(vars["version"], 10, 32)
-	req := pb.DeleteEntitySchemaRequest{}
-
-	req.EntityName = entityName
-	req.Version = int32(version)
+	productId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.UpdateProductRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
 
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.DeleteEntitySchema(ctx, &req)
+	current := mh.currentProduct(ctx, productId)
+	base := &patchBase{current: current}
+	currentJsonData := func() string {
+		resp, _, found := base.get()
+		if !found {
+			return ""
+		}
+
+		return resp.(*pb.GetProductResponse).GetProduct().GetJsonData()
+	}
+
+	req.UpdateMask, err = mergePatchRequest(buf, &req, "product_id", currentJsonData)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.ProductId = productId
+	if !checkPatchBase(&req.Version, base, r, w) {
+		return
+	}
 
+	resp, err := mh.auth.UpdateProduct(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
 		return
 	}
 
 	w.WriteHeader(503)
 
 	return
-
 }
 
-// Handle GetEntitySchema. Expects a GET request and nil  body.
-func (mh *muxHandler) EntitySchemaHandler(w http.ResponseWriter, r *http.Request) {
+func (mh *muxHandler) DeleteProductHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	entityName := vars["name"]
-	req := pb.GetEntitySchemaRequest{}
-	req.EntityName = entityName
+	productId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	version, _ := strconv.ParseInt(vars["version"], 10, 32)
+
+	req := pb.DeleteProductRequest{}
+	req.ProductId = productId
+	req.Version = int32(version)
+
+	reassignTo, err := getReassignTo(r, 64)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.ReassignTo = reassignTo
+
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.GetEntitySchema(ctx, &req)
+	current := mh.currentProduct(ctx, req.GetProductId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.DeleteProduct(ctx, &req)
 	if err == nil {
-		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), 0, current, r, w)
 		return
 	}
 
 	w.WriteHeader(503)
 
 	return
-
 }
 
-// Handle GetEntitySchemas. Expects a GET request and nil  body.
-func (mh *muxHandler) EntitySchemasHandler(w http.ResponseWriter, r *http.Request) {
-	req := pb.GetEntitySchemasRequest{}
+// Handle UndeleteProduct. Expects a POST request and nil body.
+func (mh *muxHandler) UndeleteProductHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, _ := strconv.ParseInt(vars["id"], 10, 64)
+	version, _ := strconv.ParseInt(vars["version"], 10, 32)
+
+	req := pb.UndeleteProductRequest{}
+	req.ProductId = id
+	req.Version = int32(version)
 
 	ctx := getTokenContext(r)
-	resp, err := mh.auth.GetEntitySchemas(ctx, &req)
+	resp, err := mh.auth.UndeleteProduct(ctx, &req)
 	if err == nil {
 		writeResponse(resp, err, int(resp.GetErrorCode()), w)
 		return
@@ -924,13 +1310,767 @@ func (mh *muxHandler) EntitySchemasHandler(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(503)
 
 	return
-
 }
 
-// Handle GetServerVersion. Expects a GET request and nil body. Does not require valid JWT.
-func (mh *muxHandler) ServerVersionHandler(w http.ResponseWriter, r *http.Request) {
-	req := pb.GetServerVersionRequest{}
-	req.DummyParam = 1
+func (mh *muxHandler) GetProductHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.GetProductRequest{}
+	req.ProductId = productId
+	includeDeleted, err := getIncludeDeleted(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.IncludeDeleted = includeDeleted
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetProduct(ctx, &req)
+	if err == nil {
+		writeGetResponse(resp, err, int(resp.GetErrorCode()), resp.GetProduct().GetVersion(), r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+func (mh *muxHandler) GetProductsHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.GetProductsRequest{}
+	pageSize, pageToken, totalCount, err := getPageParams(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.PageSize = pageSize
+	req.PageToken = pageToken
+	req.IncludeTotalCount = totalCount
+	req.IncludeDeleted, err = getIncludeDeleted(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+	req.Filter = r.URL.Query().Get("filter")
+	req.OrderBy = r.URL.Query().Get("order_by")
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetProducts(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+// Handle StreamProducts, writing each product as a line of newline delimited json.
+func (mh *muxHandler) StreamProductsHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.StreamProductsRequest{}
+	req.Filter = r.URL.Query().Get("filter")
+	req.OrderBy = r.URL.Query().Get("order_by")
+
+	stream := &productStream{ndjsonStream{ctx: getStreamTokenContext(r), w: w}}
+	err := mh.auth.StreamProducts(&req, stream)
+	stream.finish(err)
+}
+
+func (mh *muxHandler) SearchProductsHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.SearchProductsRequest{}
+	pageSize, pageToken, _, err := getPageParams(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.Query = r.URL.Query().Get("q")
+	req.PageSize = pageSize
+	req.PageToken = pageToken
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.SearchProducts(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+// Handle GetProductsByIds, taking the ids as a comma separated ids query parameter.
+func (mh *muxHandler) GetProductsByIdsHandler(w http.ResponseWriter, r *http.Request) {
+	ids, err := getIdsParam(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req := pb.GetProductsByIdsRequest{}
+	req.ProductIds = ids
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetProductsByIds(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+func (mh *muxHandler) CreateItemHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.CreateInventoryItemRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	err = json.Unmarshal(buf, &req)
+	if err != nil {
+		w.WriteHeader(502)
+		return
+	}
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.CreateInventoryItem(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+func (mh *muxHandler) UpdateItemHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	itemId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.UpdateInventoryItemRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	err = json.Unmarshal(buf, &req)
+	if err != nil {
+		w.WriteHeader(502)
+		return
+	}
+
+	req.InventoryItemId = itemId
+
+	ctx := getTokenContext(r)
+	current := mh.currentInventoryItem(ctx, req.GetInventoryItemId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.UpdateInventoryItem(ctx, &req)
+	if err == nil {
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+// Handle a JSON Merge Patch of an inventory item. Expects a PATCH request and a body with the version and the fields to change.
+func (mh *muxHandler) PatchItemHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	itemId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.UpdateInventoryItemRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	ctx := getTokenContext(r)
+	current := mh.currentInventoryItem(ctx, itemId)
+	base := &patchBase{current: current}
+	currentJsonData := func() string {
+		resp, _, found := base.get()
+		if !found {
+			return ""
+		}
+
+		return resp.(*pb.GetInventoryItemResponse).GetInventoryItem().GetJsonData()
+	}
+
+	req.UpdateMask, err = mergePatchRequest(buf, &req, "inventory_item_id", currentJsonData)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.InventoryItemId = itemId
+	if !checkPatchBase(&req.Version, base, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.UpdateInventoryItem(ctx, &req)
+	if err == nil {
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+func (mh *muxHandler) DeleteItemHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	itemId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	version, _ := strconv.ParseInt(vars["version"], 10, 32)
+	req := pb.DeleteInventoryItemRequest{}
+	req.InventoryItemId = itemId
+	req.Version = int32(version)
+	ctx := getTokenContext(r)
+	current := mh.currentInventoryItem(ctx, req.GetInventoryItemId())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.DeleteInventoryItem(ctx, &req)
+	if err == nil {
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), 0, current, r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+
+}
+
+// Handle UndeleteInventoryItem. Expects a POST request and nil body.
+func (mh *muxHandler) UndeleteItemHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id, _ := strconv.ParseInt(vars["id"], 10, 64)
+	version, _ := strconv.ParseInt(vars["version"], 10, 32)
+
+	req := pb.UndeleteInventoryItemRequest{}
+	req.InventoryItemId = id
+	req.Version = int32(version)
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.UndeleteInventoryItem(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+func (mh *muxHandler) GetItemHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	itemId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.GetInventoryItemRequest{}
+	req.InventoryItemId = itemId
+	includeDeleted, err := getIncludeDeleted(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.IncludeDeleted = includeDeleted
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetInventoryItem(ctx, &req)
+	if err == nil {
+		writeGetResponse(resp, err, int(resp.GetErrorCode()), resp.GetInventoryItem().GetVersion(), r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+func (mh *muxHandler) GetItemsByProductHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	productId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.GetInventoryItemsByProductRequest{}
+	req.ProductId = productId
+	pageSize, pageToken, totalCount, err := getPageParams(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.PageSize = pageSize
+	req.PageToken = pageToken
+	req.IncludeTotalCount = totalCount
+	req.IncludeDeleted, err = getIncludeDeleted(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+	req.Filter = r.URL.Query().Get("filter")
+	req.OrderBy = r.URL.Query().Get("order_by")
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetInventoryItemsByProduct(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+func (mh *muxHandler) GetItemsBySubareaHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	subareaId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.GetInventoryItemsBySubareaRequest{}
+	req.SubareaId = subareaId
+	pageSize, pageToken, totalCount, err := getPageParams(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.PageSize = pageSize
+	req.PageToken = pageToken
+	req.IncludeTotalCount = totalCount
+	req.IncludeDeleted, err = getIncludeDeleted(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+	req.Filter = r.URL.Query().Get("filter")
+	req.OrderBy = r.URL.Query().Get("order_by")
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetInventoryItemsBySubarea(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+func (mh *muxHandler) GetItemsByFacilityHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	facilityId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.GetInventoryItemsByFacilityRequest{}
+	req.FacilityId = facilityId
+	pageSize, pageToken, totalCount, err := getPageParams(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.PageSize = pageSize
+	req.PageToken = pageToken
+	req.IncludeTotalCount = totalCount
+	req.IncludeDeleted, err = getIncludeDeleted(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+	req.Filter = r.URL.Query().Get("filter")
+	req.OrderBy = r.URL.Query().Get("order_by")
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetInventoryItemsByFacility(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+// Handle StreamInventoryItemsByFacility, writing each inventory item as a line of newline delimited json.
+func (mh *muxHandler) StreamItemsByFacilityHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	facilityId, _ := strconv.ParseInt(vars["id"], 10, 64)
+	req := pb.StreamInventoryItemsByFacilityRequest{}
+	req.FacilityId = facilityId
+	req.Filter = r.URL.Query().Get("filter")
+	req.OrderBy = r.URL.Query().Get("order_by")
+
+	stream := &itemStream{ndjsonStream{ctx: getStreamTokenContext(r), w: w}}
+	err := mh.auth.StreamInventoryItemsByFacility(&req, stream)
+	stream.finish(err)
+}
+
+// Handle GetInventoryItemsByIds, taking the ids as a comma separated ids query parameter.
+func (mh *muxHandler) GetItemsByIdsHandler(w http.ResponseWriter, r *http.Request) {
+	ids, err := getIdsParam(r)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req := pb.GetInventoryItemsByIdsRequest{}
+	req.InventoryItemIds = ids
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetInventoryItemsByIds(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+// Handle WatchInventory as Server-Sent Events, for the facility_id, subarea_id or product_id query parameter.
+// A watch resumes from the Last-Event-ID header, or from the resume_token query parameter.
+func (mh *muxHandler) WatchInventoryHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.WatchInventoryRequest{}
+	query := r.URL.Query()
+	for _, p := range []struct {
+		name  string
+		field *int64
+	}{{"facility_id", &req.FacilityId}, {"subarea_id", &req.SubareaId}, {"product_id", &req.ProductId}} {
+		if query.Get(p.name) == "" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(query.Get(p.name), 10, 64)
+		if err != nil {
+			w.WriteHeader(400)
+			return
+		}
+
+		*p.field = id
+	}
+
+	req.ResumeToken = query.Get("resume_token")
+	if lastEventId := r.Header.Get("Last-Event-ID"); lastEventId != "" {
+		req.ResumeToken = lastEventId
+	}
+
+	w.Header().Set("Cache-Control", "no-cache")
+
+	stream := &watchStream{ndjsonStream{ctx: getStreamTokenContext(r), w: w}}
+	err := mh.auth.WatchInventory(&req, stream)
+	stream.finish(err)
+}
+
+// Handle GetChangesSince, for the cursor and page_size query parameters.
+func (mh *muxHandler) GetChangesSinceHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.GetChangesSinceRequest{}
+	query := r.URL.Query()
+	if val := query.Get("page_size"); val != "" {
+		pageSize, err := strconv.ParseInt(val, 10, 32)
+		if err != nil {
+			w.WriteHeader(400)
+			return
+		}
+
+		req.PageSize = int32(pageSize)
+	}
+
+	req.Cursor = query.Get("cursor")
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetChangesSince(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+// Handle CreateEntitySchema. Expects a POST request and CreateEntitySchemaRequest body.
+func (mh *muxHandler) CreateEntitySchemaHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.CreateEntitySchemaRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	err = json.Unmarshal(buf, &req)
+	if err != nil {
+		w.WriteHeader(502)
+		return
+	}
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.CreateEntitySchema(ctx, &req)
+
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+
+}
+
+// Handle UpdateEntitySchema. Expects a PUT request and CreateEntitySchemaRequest body.
+func (mh *muxHandler) UpdateEntitySchemaHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	entityName, _ := vars["name"]
+	req := pb.UpdateEntitySchemaRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	err = json.Unmarshal(buf, &req)
+	if err != nil {
+		w.WriteHeader(502)
+		return
+	}
+
+	req.EntityName = entityName
+
+	ctx := getTokenContext(r)
+	current := mh.currentEntitySchema(ctx, req.GetEntityName())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.UpdateEntitySchema(ctx, &req)
+
+	if err == nil {
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+
+}
+
+// Handle a JSON Merge Patch of an entity schema. Expects a PATCH request and a body with the version and the fields to change.
+func (mh *muxHandler) PatchEntitySchemaHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	entityName := vars["name"]
+	req := pb.UpdateEntitySchemaRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	ctx := getTokenContext(r)
+	req.UpdateMask, err = mergePatchRequest(buf, &req, "entity_name", nil)
+	if err != nil {
+		w.WriteHeader(400)
+		return
+	}
+
+	req.EntityName = entityName
+	current := mh.currentEntitySchema(ctx, req.GetEntityName())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.UpdateEntitySchema(ctx, &req)
+	if err == nil {
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), resp.GetVersion(), current, r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+// Handle DeleteEntitySchema. Expects a DELETE request and nil body.
+func (mh *muxHandler) DeleteEntitySchemaHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	entityName, _ := vars["name"]
+	version, _ := strconv.ParseInt(vars["version"], 10, 32)
+	req := pb.DeleteEntitySchemaRequest{}
+
+	req.EntityName = entityName
+	req.Version = int32(version)
+
+	ctx := getTokenContext(r)
+	current := mh.currentEntitySchema(ctx, req.GetEntityName())
+	if !checkIfMatch(&req.Version, current, r, w) {
+		return
+	}
+
+	resp, err := mh.auth.DeleteEntitySchema(ctx, &req)
+
+	if err == nil {
+		writeConditionalResponse(resp, err, int(resp.GetErrorCode()), 0, current, r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+
+}
+
+// Handle GetEntitySchema. Expects a GET request and nil  body.
+func (mh *muxHandler) EntitySchemaHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	entityName := vars["name"]
+	req := pb.GetEntitySchemaRequest{}
+	req.EntityName = entityName
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetEntitySchema(ctx, &req)
+	if err == nil {
+		writeGetResponse(resp, err, int(resp.GetErrorCode()), resp.GetEntitySchema().GetVersion(), r, w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+
+}
+
+// Handle GetEntitySchemas. Expects a GET request and nil  body.
+func (mh *muxHandler) EntitySchemasHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.GetEntitySchemasRequest{}
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetEntitySchemas(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+
+}
+
+// Handle CreateJsonIndex. Expects a POST request and CreateJsonIndexRequest body.
+func (mh *muxHandler) CreateJsonIndexHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.CreateJsonIndexRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	err = json.Unmarshal(buf, &req)
+	if err != nil {
+		w.WriteHeader(502)
+		return
+	}
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.CreateJsonIndex(ctx, &req)
+
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+
+}
+
+// Handle DeleteJsonIndex. Expects a DELETE request with the json path in the path query parameter and nil body.
+func (mh *muxHandler) DeleteJsonIndexHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	req := pb.DeleteJsonIndexRequest{}
+
+	req.EntityName = vars["name"]
+	req.ValueType = vars["type"]
+	req.JsonPath = r.URL.Query().Get("path")
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.DeleteJsonIndex(ctx, &req)
+
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+
+}
+
+// Handle GetJsonIndexes. Expects a GET request and nil  body.
+func (mh *muxHandler) JsonIndexesHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.GetJsonIndexesRequest{}
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.GetJsonIndexes(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+
+}
+
+// Handle Batch. Expects a POST request and BatchRequest body, in the protobuf json mapping so the operations
+// can be decoded.
+func (mh *muxHandler) BatchHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.BatchRequest{}
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(501)
+		return
+	}
+
+	err = protojson.Unmarshal(buf, &req)
+	if err != nil {
+		w.WriteHeader(502)
+		return
+	}
+
+	ctx := getTokenContext(r)
+	resp, err := mh.auth.Batch(ctx, &req)
+	if err == nil {
+		writeResponse(resp, err, int(resp.GetErrorCode()), w)
+		return
+	}
+
+	w.WriteHeader(503)
+
+	return
+}
+
+// Handle GetServerVersion. Expects a GET request and nil body. Does not require valid JWT.
+func (mh *muxHandler) ServerVersionHandler(w http.ResponseWriter, r *http.Request) {
+	req := pb.GetServerVersionRequest{}
+	req.DummyParam = 1
 	ctx := getTokenContext(r)
 	resp, err := mh.auth.GetServerVersion(ctx, &req)
 
@@ -944,6 +2084,416 @@ func (mh *muxHandler) ServerVersionHandler(w http.ResponseWriter, r *http.Reques
 	return
 }
 
+// Gets the current representation of a record for a conditional request, with its version and whether it exists.
+type currentFunc func() (interface{}, int32, bool)
+
+// Helper to make the ETag of a record version.
+func versionETag(version int32) string {
+	return fmt.Sprintf("\"%d\"", version)
+}
+
+// Helper to make the ETag of a get response: the record version, then a hash of the body. The body also carries the
+// names of the records it refers to, such as its facility or product name, which change without a new version of
+// the record, so a cached body is only current if the whole tag matches.
+func representationETag(version int32, resp interface{}) string {
+	jtext, _ := json.Marshal(resp)
+	sum := sha256.Sum256(jtext)
+
+	return fmt.Sprintf("\"%d-%s\"", version, hex.EncodeToString(sum[:8]))
+}
+
+// Helper to check if an If-None-Match header lists an ETag, or is *. The comparison is weak, so a W/ prefix is
+// ignored.
+func etagListContains(header string, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if (tag == "*") || (tag == etag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper to check if an If-Match header lists an ETag of a version, or is *. The comparison is strong, so a weak
+// tag never matches. Only the version is compared, so the ETag of a get, with the hash of its body after the
+// version, matches as long as the record has that version.
+func etagListMatches(header string, version int32) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+
+		if (len(tag) < 2) || !strings.HasPrefix(tag, "\"") || !strings.HasSuffix(tag, "\"") {
+			continue
+		}
+
+		tagVersion, _, _ := strings.Cut(tag[1:len(tag)-1], "-")
+		if tagVersion == strconv.Itoa(int(version)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper to write the response of a get of one record with an ETag of its version and body, or 304 Not Modified
+// without a body if the If-None-Match header lists that ETag.
+func writeGetResponse(resp interface{}, err error, errCode int, version int32, r *http.Request, w http.ResponseWriter) {
+	if (err == nil) && (errCode == 0) {
+		etag := representationETag(version, resp)
+		w.Header().Set("ETag", etag)
+		if inm := r.Header.Get("If-None-Match"); (inm != "") && etagListContains(inm, etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	writeResponse(resp, err, errCode, w)
+}
+
+// Helper to apply the If-Match header of an update or delete. With the header, the current record must have an
+// ETag it lists, and the version of the current record is used for the write. Without it, the version given in the
+// body or path is used, and one must be given. Returns false after writing 412 Precondition Failed with the current
+// representation, or 428 Precondition Required.
+func checkIfMatch(version *int32, current currentFunc, r *http.Request, w http.ResponseWriter) bool {
+	im := r.Header.Get("If-Match")
+	if im == "" {
+		if *version == 0 {
+			writeResponse(map[string]interface{}{"error_code": http.StatusPreconditionRequired,
+				"error_message": "version or If-Match header required"}, nil, http.StatusPreconditionRequired, w)
+			return false
+		}
+
+		return true
+	}
+
+	resp, currentVersion, found := current()
+	if found && etagListMatches(im, currentVersion) {
+		*version = currentVersion
+		return true
+	}
+
+	writePreconditionFailed(resp, currentVersion, found, w)
+	return false
+}
+
+// A record read once for a patch, so that the json_data merged into the patch and the version the update is made
+// against come from the same read.
+type patchBase struct {
+	current currentFunc
+	read    bool
+	resp    interface{}
+	version int32
+	found   bool
+}
+
+// Helper to read the record the first time it is needed, and return that read after.
+func (base *patchBase) get() (interface{}, int32, bool) {
+	if !base.read {
+		base.resp, base.version, base.found = base.current()
+		base.read = true
+	}
+
+	return base.resp, base.version, base.found
+}
+
+// Helper to apply the If-Match header of a patch, as checkIfMatch does, against the record read for the patch. If
+// json_data was merged into that record, a version given in the body must also be its version, or the update would
+// drop the changes made since; returns false after writing 409 Conflict with the record otherwise.
+func checkPatchBase(version *int32, base *patchBase, r *http.Request, w http.ResponseWriter) bool {
+	if !checkIfMatch(version, base.get, r, w) {
+		return false
+	}
+
+	if base.read && base.found && (*version != base.version) {
+		w.Header().Set("ETag", versionETag(base.version))
+		writeResponse(map[string]interface{}{"error_code": http.StatusConflict,
+			"error_message": fmt.Sprintf("version conflict, current version is %d", base.version),
+			"version":       base.version, "current": base.resp}, nil, http.StatusConflict, w)
+		return false
+	}
+
+	return true
+}
+
+// Helper to write the response of a conditional update or delete, with the ETag of the new version on success.
+// A version conflict is 409 Conflict with the current record and its ETag, or 412 Precondition Failed if the
+// request gave its version in If-Match.
+func writeConditionalResponse(resp interface{}, err error, errCode int, version int32, current currentFunc,
+	r *http.Request, w http.ResponseWriter) {
+
+	if (err == nil) && (errCode == 409) && (r.Header.Get("If-Match") != "") {
+		currentResp, currentVersion, found := current()
+		if found {
+			writePreconditionFailed(currentResp, currentVersion, found, w)
+			return
+		}
+	}
+
+	if (err == nil) && (errCode == 409) {
+		if vresp, ok := resp.(interface{ GetVersion() int32 }); ok && (vresp.GetVersion() != 0) {
+			w.Header().Set("ETag", versionETag(vresp.GetVersion()))
+		}
+	}
+
+	if (err == nil) && (errCode == 0) && (version != 0) {
+		w.Header().Set("ETag", versionETag(version))
+	}
+
+	writeResponse(resp, err, errCode, w)
+}
+
+// Helper to write 412 Precondition Failed with the current representation of the record and its ETag, if it exists.
+func writePreconditionFailed(resp interface{}, version int32, found bool, w http.ResponseWriter) {
+	if found {
+		w.Header().Set("ETag", versionETag(version))
+	}
+
+	writeResponse(resp, nil, http.StatusPreconditionFailed, w)
+}
+
+// Helper to get the current facility for a conditional request.
+func (mh *muxHandler) currentFacility(ctx context.Context, key int64) currentFunc {
+	return func() (interface{}, int32, bool) {
+		resp, err := mh.auth.GetFacility(ctx, &pb.GetFacilityRequest{FacilityId: key})
+		if (err != nil) || (resp.GetErrorCode() != 0) {
+			return resp, 0, false
+		}
+
+		return resp, resp.GetFacility().GetVersion(), true
+	}
+}
+
+// Helper to get the current subarea type for a conditional request.
+func (mh *muxHandler) currentSubareaType(ctx context.Context, key int32) currentFunc {
+	return func() (interface{}, int32, bool) {
+		resp, err := mh.auth.GetSubareaType(ctx, &pb.GetSubareaTypeRequest{SubareaTypeId: key})
+		if (err != nil) || (resp.GetErrorCode() != 0) {
+			return resp, 0, false
+		}
+
+		return resp, resp.GetSubareaType().GetVersion(), true
+	}
+}
+
+// Helper to get the current item type for a conditional request.
+func (mh *muxHandler) currentItemType(ctx context.Context, key int32) currentFunc {
+	return func() (interface{}, int32, bool) {
+		resp, err := mh.auth.GetItemType(ctx, &pb.GetItemTypeRequest{ItemTypeId: key})
+		if (err != nil) || (resp.GetErrorCode() != 0) {
+			return resp, 0, false
+		}
+
+		return resp, resp.GetItemType().GetVersion(), true
+	}
+}
+
+// Helper to get the current subarea for a conditional request.
+func (mh *muxHandler) currentSubarea(ctx context.Context, key int64) currentFunc {
+	return func() (interface{}, int32, bool) {
+		resp, err := mh.auth.GetSubarea(ctx, &pb.GetSubareaRequest{SubareaId: key})
+		if (err != nil) || (resp.GetErrorCode() != 0) {
+			return resp, 0, false
+		}
+
+		return resp, resp.GetSubarea().GetVersion(), true
+	}
+}
+
+// Helper to get the current product for a conditional request.
+func (mh *muxHandler) currentProduct(ctx context.Context, key int64) currentFunc {
+	return func() (interface{}, int32, bool) {
+		resp, err := mh.auth.GetProduct(ctx, &pb.GetProductRequest{ProductId: key})
+		if (err != nil) || (resp.GetErrorCode() != 0) {
+			return resp, 0, false
+		}
+
+		return resp, resp.GetProduct().GetVersion(), true
+	}
+}
+
+// Helper to get the current inventory item for a conditional request.
+func (mh *muxHandler) currentInventoryItem(ctx context.Context, key int64) currentFunc {
+	return func() (interface{}, int32, bool) {
+		resp, err := mh.auth.GetInventoryItem(ctx, &pb.GetInventoryItemRequest{InventoryItemId: key})
+		if (err != nil) || (resp.GetErrorCode() != 0) {
+			return resp, 0, false
+		}
+
+		return resp, resp.GetInventoryItem().GetVersion(), true
+	}
+}
+
+// Helper to get the current entity schema for a conditional request.
+func (mh *muxHandler) currentEntitySchema(ctx context.Context, key string) currentFunc {
+	return func() (interface{}, int32, bool) {
+		resp, err := mh.auth.GetEntitySchema(ctx, &pb.GetEntitySchemaRequest{EntityName: key})
+		if (err != nil) || (resp.GetErrorCode() != 0) {
+			return resp, 0, false
+		}
+
+		return resp, resp.GetEntitySchema().GetVersion(), true
+	}
+}
+
+// Helper to get the page_size, page_token and include_total_count query parameters of a list request.
+func getPageParams(r *http.Request) (int32, string, bool, error) {
+	var pageSize int64
+	var totalCount bool
+	var err error
+
+	query := r.URL.Query()
+	if val := query.Get("page_size"); val != "" {
+		pageSize, err = strconv.ParseInt(val, 10, 32)
+		if err != nil {
+			return 0, "", false, err
+		}
+	}
+
+	if val := query.Get("include_total_count"); val != "" {
+		totalCount, err = strconv.ParseBool(val)
+		if err != nil {
+			return 0, "", false, err
+		}
+	}
+
+	return int32(pageSize), query.Get("page_token"), totalCount, nil
+}
+
+// Helper to get the include_deleted query parameter of a get or list request.
+func getIncludeDeleted(r *http.Request) (bool, error) {
+	val := r.URL.Query().Get("include_deleted")
+	if val == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(val)
+}
+
+// Helper to get the reassign_to query parameter of a delete, zero if not given.
+func getReassignTo(r *http.Request, bitSize int) (int64, error) {
+	val := r.URL.Query().Get("reassign_to")
+	if val == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(val, 10, bitSize)
+}
+
+// Helper to get the comma separated ids query parameter of a get by ids request.
+func getIdsParam(r *http.Request) ([]int64, error) {
+	var ids []int64
+	for _, val := range strings.Split(r.URL.Query().Get("ids"), ",") {
+		val = strings.TrimSpace(val)
+		if val == "" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// Helper to convert a JSON Merge Patch (RFC 7396) body into an update request whose update mask lists the patched
+// fields. The version and the key field taken from the URL are not part of the mask, and a null resets a field.
+// A json_data object is merged into the current json_data returned by currentJsonData, if given.
+func mergePatchRequest(buf []byte, req interface{}, key string, currentJsonData func() string) (*fieldmaskpb.FieldMask, error) {
+	var patch map[string]json.RawMessage
+	err := json.Unmarshal(buf, &patch)
+	if err != nil {
+		return nil, err
+	}
+
+	if patch == nil {
+		return nil, errors.New("patch must be a json object")
+	}
+
+	paths := make([]string, 0, len(patch))
+	for field, value := range patch {
+		if (field == "version") || (field == key) {
+			continue
+		}
+
+		if (field == "json_data") && (currentJsonData != nil) && bytes.HasPrefix(bytes.TrimSpace(value), []byte("{")) {
+			merged, err := mergeJsonData(currentJsonData(), value)
+			if err != nil {
+				return nil, err
+			}
+
+			patch[field], _ = json.Marshal(merged)
+		}
+
+		paths = append(paths, field)
+	}
+
+	if len(paths) == 0 {
+		return nil, errors.New("patch has no fields to update")
+	}
+
+	sort.Strings(paths)
+
+	buf, _ = json.Marshal(patch)
+	err = json.Unmarshal(buf, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fieldmaskpb.FieldMask{Paths: paths}, nil
+}
+
+// Helper to apply a JSON Merge Patch to json_data text, treating missing or invalid json_data as an empty object.
+func mergeJsonData(current string, patch json.RawMessage) (string, error) {
+	var target interface{}
+	dec := json.NewDecoder(strings.NewReader(current))
+	dec.UseNumber()
+	if dec.Decode(&target) != nil {
+		target = nil
+	}
+
+	var patchValue interface{}
+	dec = json.NewDecoder(bytes.NewReader(patch))
+	dec.UseNumber()
+	err := dec.Decode(&patchValue)
+	if err != nil {
+		return "", err
+	}
+
+	buf, err := json.Marshal(mergePatch(target, patchValue))
+
+	return string(buf), err
+}
+
+// Helper implementing the RFC 7396 merge of a patch value into a target value.
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = make(map[string]interface{})
+	}
+
+	for name, value := range patchObj {
+		if value == nil {
+			delete(targetObj, name)
+		} else {
+			targetObj[name] = mergePatch(targetObj[name], value)
+		}
+	}
+
+	return targetObj
+}
+
 // Helper to write method response as json to ResponseWriter.
 func writeResponse(resp interface{}, err error, errCode int, w http.ResponseWriter) {
 	if err != nil {
@@ -967,9 +2517,141 @@ func writeResponse(resp interface{}, err error, errCode int, w http.ResponseWrit
 	_, _ = w.Write(jtext)
 }
 
+// Adapts a ResponseWriter to a grpc server stream, writing each message sent as a line of newline delimited json.
+// The error code of the first message becomes the HTTP status, later errors can only be reported in the body.
+type ndjsonStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	w       http.ResponseWriter
+	started bool
+}
+
+func (st *ndjsonStream) Context() context.Context {
+	return st.ctx
+}
+
+// Helper to write one stream message as a json line and flush it to the client.
+func (st *ndjsonStream) write(msg interface{}, errCode int32) error {
+	jtext, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return st.writeRaw(append(jtext, '\n'), "application/x-ndjson", errCode)
+}
+
+// Helper to write stream output and flush it to the client. The first output starts the response with the
+// content type, and with the error code as the HTTP status.
+func (st *ndjsonStream) writeRaw(buf []byte, contentType string, errCode int32) error {
+	if !st.started {
+		st.started = true
+		st.w.Header().Set("Content-Type", contentType)
+		if errCode == 0 {
+			st.w.WriteHeader(200)
+		} else {
+			st.w.WriteHeader(int(errCode))
+		}
+	}
+
+	_, err := st.w.Write(buf)
+	if err != nil {
+		return err
+	}
+
+	if flusher, ok := st.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
+
+// Helper to end the response of a stream, which may not have sent any message.
+func (st *ndjsonStream) finish(err error) {
+	if st.started {
+		return
+	}
+
+	if err != nil {
+		st.w.WriteHeader(503)
+		return
+	}
+
+	st.w.Header().Set("Content-Type", "application/x-ndjson")
+	st.w.WriteHeader(200)
+}
+
+type subareaStream struct {
+	ndjsonStream
+}
+
+func (st *subareaStream) Send(resp *pb.StreamSubareasResponse) error {
+	return st.write(resp, resp.GetErrorCode())
+}
+
+type productStream struct {
+	ndjsonStream
+}
+
+func (st *productStream) Send(resp *pb.StreamProductsResponse) error {
+	return st.write(resp, resp.GetErrorCode())
+}
+
+type itemStream struct {
+	ndjsonStream
+}
+
+func (st *itemStream) Send(resp *pb.StreamInventoryItemsByFacilityResponse) error {
+	return st.write(resp, resp.GetErrorCode())
+}
+
+// Adapts a ResponseWriter to the watch_inventory stream as Server-Sent Events. The resume token of each message is
+// its event id, so a reconnecting EventSource resumes the watch through the Last-Event-ID header.
+type watchStream struct {
+	ndjsonStream
+}
+
+func (st *watchStream) Send(resp *pb.WatchInventoryResponse) error {
+	var buf bytes.Buffer
+	if resp.GetResumeToken() != "" {
+		buf.WriteString("id: " + resp.GetResumeToken() + "\n")
+	}
+
+	switch {
+	case resp.GetErrorCode() != 0:
+		jtext, err := json.Marshal(&pb.WatchInventoryResponse{ErrorCode: resp.GetErrorCode(), ErrorMessage: resp.GetErrorMessage()})
+		if err != nil {
+			return err
+		}
+		buf.WriteString("event: error\ndata: " + string(jtext) + "\n")
+
+	case resp.GetEvent() != nil:
+		jtext, err := json.Marshal(resp.GetEvent())
+		if err != nil {
+			return err
+		}
+		buf.WriteString("event: " + resp.GetEvent().GetEventType() + "\ndata: " + string(jtext) + "\n")
+
+	default:
+		buf.WriteString(": heartbeat\n")
+	}
+
+	buf.WriteString("\n")
+
+	return st.writeRaw(buf.Bytes(), "text/event-stream", resp.GetErrorCode())
+}
+
 // Gets agrpc context that contains the JWT from the Authorization HTTP header, if available.
 func getTokenContext(r *http.Request) context.Context {
-	ctx := context.Background()
+	return tokenContext(context.Background(), r)
+}
+
+// Gets a grpc context for a stream, that contains the JWT and is cancelled when the client goes away.
+func getStreamTokenContext(r *http.Request) context.Context {
+	return tokenContext(r.Context(), r)
+}
+
+// Helper to add the JWT from the Authorization HTTP header, if available, and any Idempotency-Key header to a context.
+func tokenContext(ctx context.Context, r *http.Request) context.Context {
 	var token string
 	authHeader := r.Header.Get("Authorization")
 	if (len(authHeader) > 7) && (authHeader[0:7] == "Bearer ") {
@@ -977,6 +2659,9 @@ func getTokenContext(r *http.Request) context.Context {
 	}
 
 	md := metadata.Pairs("token", token)
+	if key := r.Header.Get("Idempotency-Key"); key != "" {
+		md.Append("idempotency-key", key)
+	}
 
 	mctx := metadata.NewIncomingContext(ctx, md)
 	return mctx
@@ -0,0 +1,203 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package muxhandler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMergeJsonData(t *testing.T) {
+	// the examples of RFC 7396 appendix A, then the current json_data cases
+	tests := []struct {
+		current string
+		patch   string
+		want    string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+		{``, `{"a":1}`, `{"a":1}`},
+		{`not json`, `{"a":{"b":null}}`, `{"a":{}}`},
+		{`{"n":12345678901234567890,"x":1.50}`, `{"x":null}`, `{"n":12345678901234567890}`},
+		{`{"a":{"b":1,"c":2}}`, `{"a":{"c":null,"d":{"e":3}}}`, `{"a":{"b":1,"d":{"e":3}}}`},
+	}
+
+	for _, tt := range tests {
+		got, err := mergeJsonData(tt.current, json.RawMessage(tt.patch))
+		if err != nil {
+			t.Errorf("mergeJsonData(%s, %s) failed: %v", tt.current, tt.patch, err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("mergeJsonData(%s, %s) = %s, want %s", tt.current, tt.patch, got, tt.want)
+		}
+	}
+
+	if _, err := mergeJsonData(`{}`, json.RawMessage(`{"a":`)); err == nil {
+		t.Errorf("mergeJsonData accepted a patch that is not json")
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	target := map[string]interface{}{"keep": "k", "drop": "d", "nested": map[string]interface{}{"x": "1"}}
+	patch := map[string]interface{}{"drop": nil, "nested": map[string]interface{}{"y": "2"}, "added": nil}
+
+	merged, _ := json.Marshal(mergePatch(target, patch))
+	if string(merged) != `{"keep":"k","nested":{"x":"1","y":"2"}}` {
+		t.Errorf("mergePatch = %s", merged)
+	}
+
+	// a patch that is not an object replaces the target, and a null patch removes it
+	if got := mergePatch(target, "s"); got != "s" {
+		t.Errorf("mergePatch with a string patch = %v", got)
+	}
+
+	if got := mergePatch(target, nil); got != nil {
+		t.Errorf("mergePatch with a null patch = %v", got)
+	}
+}
+
+func TestEtagListMatches(t *testing.T) {
+	getTag := representationETag(3, map[string]string{"product_name": "Widget"})
+
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{`"3"`, true},
+		{`"4"`, false},
+		{`3`, false},
+		{`"2", "3"`, true},
+		{` "2" ,"3" `, true},
+		{`*`, true},
+		{`W/"3"`, false},
+		{`W/"2", W/"3"`, false},
+		{`"33"`, false},
+		{`"3-0123abcd"`, true},
+		{getTag, true},
+		{`"4-0123abcd"`, false},
+		{`"-3"`, false},
+		{`"`, false},
+		{``, false},
+	}
+
+	for _, tt := range tests {
+		if got := etagListMatches(tt.header, 3); got != tt.want {
+			t.Errorf("etagListMatches(%s, 3) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestEtagListContains(t *testing.T) {
+	etag := representationETag(3, map[string]string{"product_name": "Widget"})
+
+	if !strings.HasPrefix(etag, `"3-`) || !strings.HasSuffix(etag, `"`) {
+		t.Fatalf("representationETag = %s, want the version then the body hash", etag)
+	}
+
+	renamed := representationETag(3, map[string]string{"product_name": "Gadget"})
+	if renamed == etag {
+		t.Errorf("a body with another joined name has the same ETag %s", etag)
+	}
+
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{etag, true},
+		{"W/" + etag, true},
+		{`"1", ` + etag, true},
+		{`*`, true},
+		{`"3"`, false},
+		{renamed, false},
+		{`W/"3"`, false},
+		{``, false},
+	}
+
+	for _, tt := range tests {
+		if got := etagListContains(tt.header, etag); got != tt.want {
+			t.Errorf("etagListContains(%s, %s) = %v, want %v", tt.header, etag, got, tt.want)
+		}
+	}
+}
+
+func TestCheckPatchBase(t *testing.T) {
+	reads := 0
+	current := func() (interface{}, int32, bool) {
+		reads++
+		return map[string]int{"read": reads}, int32(4 + reads), true
+	}
+
+	tests := []struct {
+		name     string
+		ifMatch  string
+		version  int32
+		merged   bool
+		ok       bool
+		status   int
+		wantVers int32
+	}{
+		{"body version, no merge", "", 3, false, true, http.StatusOK, 3},
+		{"body version of the merged read", "", 5, true, true, http.StatusOK, 5},
+		{"stale body version after a merge", "", 4, true, false, http.StatusConflict, 4},
+		{"if-match of the merged read", `"5"`, 0, true, true, http.StatusOK, 5},
+		{"if-match of a later version", `"6"`, 0, true, false, http.StatusPreconditionFailed, 0},
+		{"if-match without a merge", `"5"`, 0, false, true, http.StatusOK, 5},
+		{"no version", "", 0, false, false, http.StatusPreconditionRequired, 0},
+	}
+
+	for _, tt := range tests {
+		reads = 0
+		base := &patchBase{current: current}
+		if tt.merged {
+			base.get()
+		}
+
+		r := httptest.NewRequest("PATCH", "/api/product/1", nil)
+		if tt.ifMatch != "" {
+			r.Header.Set("If-Match", tt.ifMatch)
+		}
+
+		w := httptest.NewRecorder()
+		version := tt.version
+		if ok := checkPatchBase(&version, base, r, w); (ok != tt.ok) || (w.Code != tt.status) {
+			t.Errorf("%s: checkPatchBase = %v with status %d, want %v with %d", tt.name, ok, w.Code, tt.ok, tt.status)
+		}
+
+		if tt.ok && (version != tt.wantVers) {
+			t.Errorf("%s: version = %d, want %d", tt.name, version, tt.wantVers)
+		}
+
+		if reads > 1 {
+			t.Errorf("%s: record read %d times", tt.name, reads)
+		}
+	}
+}
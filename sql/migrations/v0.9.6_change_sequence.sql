@@ -0,0 +1,124 @@
+use inventory;
+
+-- v0.9.6: change sequence numbers read by the get_changes_since method.
+-- Existing rows keep change sequence 0 and are returned by a synchronization that starts without a cursor.
+
+CREATE TABLE IF NOT EXISTS tb_ChangeSequence
+(
+    inbMserviceId BIGINT NOT NULL,
+    inbChangeSeq BIGINT NOT NULL,
+
+    PRIMARY KEY (inbMserviceId)
+) ENGINE=InnoDB;
+
+ALTER TABLE tb_Facility ADD COLUMN inbChangeSeq BIGINT NOT NULL DEFAULT 0, ADD INDEX (inbMserviceId,inbChangeSeq);
+
+ALTER TABLE tb_SubareaType ADD COLUMN inbChangeSeq BIGINT NOT NULL DEFAULT 0, ADD INDEX (inbMserviceId,inbChangeSeq);
+
+ALTER TABLE tb_ItemType ADD COLUMN inbChangeSeq BIGINT NOT NULL DEFAULT 0, ADD INDEX (inbMserviceId,inbChangeSeq);
+
+ALTER TABLE tb_Subarea ADD COLUMN inbChangeSeq BIGINT NOT NULL DEFAULT 0, ADD INDEX (inbMserviceId,inbChangeSeq);
+
+ALTER TABLE tb_Product ADD COLUMN inbChangeSeq BIGINT NOT NULL DEFAULT 0, ADD INDEX (inbMserviceId,inbChangeSeq);
+
+ALTER TABLE tb_InventoryItem ADD COLUMN inbChangeSeq BIGINT NOT NULL DEFAULT 0, ADD INDEX (inbMserviceId,inbChangeSeq);
+
+DELIMITER //
+
+DROP TRIGGER IF EXISTS tr_Facility_Insert//
+CREATE TRIGGER tr_Facility_Insert BEFORE INSERT ON tb_Facility FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DROP TRIGGER IF EXISTS tr_Facility_Update//
+CREATE TRIGGER tr_Facility_Update BEFORE UPDATE ON tb_Facility FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DROP TRIGGER IF EXISTS tr_SubareaType_Insert//
+CREATE TRIGGER tr_SubareaType_Insert BEFORE INSERT ON tb_SubareaType FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DROP TRIGGER IF EXISTS tr_SubareaType_Update//
+CREATE TRIGGER tr_SubareaType_Update BEFORE UPDATE ON tb_SubareaType FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DROP TRIGGER IF EXISTS tr_ItemType_Insert//
+CREATE TRIGGER tr_ItemType_Insert BEFORE INSERT ON tb_ItemType FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DROP TRIGGER IF EXISTS tr_ItemType_Update//
+CREATE TRIGGER tr_ItemType_Update BEFORE UPDATE ON tb_ItemType FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DROP TRIGGER IF EXISTS tr_Subarea_Insert//
+CREATE TRIGGER tr_Subarea_Insert BEFORE INSERT ON tb_Subarea FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DROP TRIGGER IF EXISTS tr_Subarea_Update//
+CREATE TRIGGER tr_Subarea_Update BEFORE UPDATE ON tb_Subarea FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DROP TRIGGER IF EXISTS tr_Product_Insert//
+CREATE TRIGGER tr_Product_Insert BEFORE INSERT ON tb_Product FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DROP TRIGGER IF EXISTS tr_Product_Update//
+CREATE TRIGGER tr_Product_Update BEFORE UPDATE ON tb_Product FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DROP TRIGGER IF EXISTS tr_InventoryItem_Insert//
+CREATE TRIGGER tr_InventoryItem_Insert BEFORE INSERT ON tb_InventoryItem FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DROP TRIGGER IF EXISTS tr_InventoryItem_Update//
+CREATE TRIGGER tr_InventoryItem_Update BEFORE UPDATE ON tb_InventoryItem FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DELIMITER ;
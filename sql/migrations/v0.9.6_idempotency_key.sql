@@ -0,0 +1,16 @@
+use inventory;
+
+-- v0.9.6: responses of create methods called with a request_id, replayed on retry.
+-- Keys older than the server's idempotency_window are replaced when reused and removed as new keys are stored.
+
+CREATE TABLE IF NOT EXISTS tb_IdempotencyKey
+(
+    inbMserviceId BIGINT NOT NULL,
+    chvRequestId VARCHAR(255) NOT NULL,
+    dtmCreated DATETIME NOT NULL,
+    chvRequestHash CHAR(64) NOT NULL,
+    chvResponse VARCHAR(8000) NOT NULL,
+
+    PRIMARY KEY (inbMserviceId,chvRequestId),
+    INDEX (dtmCreated)
+) ENGINE=InnoDB;
@@ -0,0 +1,24 @@
+use inventory;
+
+-- v0.9.6: log of inventory item changes, read by the watch_inventory method.
+-- With purge_retention set, the server removes rows whose dtmCreated is older than the retention period; a watch
+-- resuming from a token before the oldest row left is refused with "resume_token expired".
+
+CREATE TABLE IF NOT EXISTS tb_InventoryEvent
+(
+    inbEventId BIGINT AUTO_INCREMENT NOT NULL,
+    dtmCreated DATETIME NOT NULL,
+    inbMserviceId BIGINT NOT NULL,
+    chvEventType VARCHAR(8) NOT NULL,
+    inbInventoryItemId BIGINT NOT NULL,
+    intVersion INT NOT NULL,
+    inbFacilityId BIGINT NOT NULL,
+    inbSubareaId BIGINT NOT NULL,
+    inbProductId BIGINT NOT NULL,
+    inbPrevFacilityId BIGINT NOT NULL,
+    inbPrevSubareaId BIGINT NOT NULL,
+    inbPrevProductId BIGINT NOT NULL,
+
+    PRIMARY KEY (inbEventId),
+    INDEX (dtmCreated)
+) ENGINE=InnoDB;
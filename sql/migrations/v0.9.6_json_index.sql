@@ -0,0 +1,18 @@
+use inventory;
+
+-- v0.9.6: registry of indexed json_data extension fields.
+-- The generated columns and indexes themselves are added to tb_Product and tb_InventoryItem
+-- by the operator with invserver json_index_column, or by the create_json_index method when the
+-- server runs with json_index_ddl, using a database user with the ALTER privilege.
+
+CREATE TABLE IF NOT EXISTS tb_JsonIndex
+(
+    inbMserviceId BIGINT NOT NULL,
+    chvEntityName VARCHAR(32) NOT NULL,
+    chvJsonPath VARCHAR(200) NOT NULL,
+    chvValueType VARCHAR(16) NOT NULL,
+    chvColumnName VARCHAR(64) NOT NULL,
+    dtmCreated DATETIME NOT NULL,
+
+    PRIMARY KEY (inbMserviceId,chvEntityName,chvJsonPath,chvValueType)
+) ENGINE=InnoDB;
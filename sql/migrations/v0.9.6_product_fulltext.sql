@@ -0,0 +1,6 @@
+use inventory;
+
+-- v0.9.6: full text index used by search_products.
+-- Building the index can take a while on large product tables.
+
+ALTER TABLE tb_Product ADD FULLTEXT (chvProductName,chvSku,chvComment);
@@ -0,0 +1,9 @@
+use inventory;
+
+-- v0.9.6: subarea names are unique within their facility and parent subarea,
+-- rather than across the whole mservice account.
+-- The new key is looser than the old one, so existing rows always satisfy it.
+
+ALTER TABLE tb_Subarea DROP INDEX inbMserviceId;
+
+ALTER TABLE tb_Subarea ADD UNIQUE (inbMserviceId,inbFacilityId,inbParentSubareaId,chvSubareaName);
@@ -0,0 +1,25 @@
+use inventory;
+
+-- v0.9.6: account configurable rules for which subarea types may nest in which,
+-- and which item types may be stored in which subarea types.
+-- A subarea type with no rules keeps the old behavior and accepts anything.
+
+CREATE TABLE IF NOT EXISTS tb_SubareaTypeNesting
+(
+    inbMserviceId BIGINT NOT NULL,
+    intParentSubareaTypeId INT NOT NULL,
+    intChildSubareaTypeId INT NOT NULL,
+    dtmCreated DATETIME NOT NULL,
+
+    PRIMARY KEY (inbMserviceId,intParentSubareaTypeId,intChildSubareaTypeId)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS tb_SubareaTypeItemType
+(
+    inbMserviceId BIGINT NOT NULL,
+    intSubareaTypeId INT NOT NULL,
+    intItemTypeId INT NOT NULL,
+    dtmCreated DATETIME NOT NULL,
+
+    PRIMARY KEY (inbMserviceId,intSubareaTypeId,intItemTypeId)
+) ENGINE=InnoDB;
@@ -0,0 +1,18 @@
+use inventory;
+
+DROP TABLE IF EXISTS tb_ChangeSequence;
+
+-- last change sequence number of an account, taken by the insert and update triggers of the synchronized tables.
+-- The row stays locked until the writing transaction commits, so the changes of an account become visible in
+-- sequence order and get_changes_since never passes a change that has not committed yet.
+CREATE TABLE tb_ChangeSequence
+(
+
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- last change sequence number given out
+    inbChangeSeq BIGINT NOT NULL,
+
+
+    PRIMARY KEY (inbMserviceId)
+) ENGINE=InnoDB;
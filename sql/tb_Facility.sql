@@ -24,9 +24,30 @@ CREATE TABLE tb_Facility
     chvFacilityName VARCHAR(255) NOT NULL,
     -- data for entity ui extensions
     chvJsonData VARCHAR(8000) NOT NULL,
+    -- account change sequence of the last write, read by get_changes_since
+    inbChangeSeq BIGINT NOT NULL DEFAULT 0,
 
 
     PRIMARY KEY (inbFacilityId),
-    UNIQUE (inbMserviceId,chvFacilityName)
+    UNIQUE (inbMserviceId,chvFacilityName),
+    INDEX (inbMserviceId,inbChangeSeq)
 ) ENGINE=InnoDB;
 
+-- number each insert and update from the account change sequence
+DELIMITER //
+
+CREATE TRIGGER tr_Facility_Insert BEFORE INSERT ON tb_Facility FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+CREATE TRIGGER tr_Facility_Update BEFORE UPDATE ON tb_Facility FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DELIMITER ;
@@ -0,0 +1,22 @@
+use inventory;
+
+DROP TABLE IF EXISTS tb_IdempotencyKey;
+
+-- response of a create method, replayed when the create is retried with the same request id
+CREATE TABLE tb_IdempotencyKey
+(
+
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- idempotency key given by the client
+    chvRequestId VARCHAR(255) NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- sha256 of the method and request the key was first used with
+    chvRequestHash CHAR(64) NOT NULL,
+    -- json of the original response
+    chvResponse VARCHAR(8000) NOT NULL,
+
+    PRIMARY KEY (inbMserviceId,chvRequestId),
+    INDEX (dtmCreated)
+) ENGINE=InnoDB;
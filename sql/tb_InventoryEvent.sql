@@ -0,0 +1,38 @@
+use inventory;
+
+DROP TABLE IF EXISTS tb_InventoryEvent;
+
+-- create, update or delete of an inventory item, read by watch_inventory
+CREATE TABLE tb_InventoryEvent
+(
+
+    -- event identifier
+    inbEventId BIGINT AUTO_INCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- type of change, create, update or delete
+    chvEventType VARCHAR(8) NOT NULL,
+    -- inventory item identifier
+    inbInventoryItemId BIGINT NOT NULL,
+    -- version of the item after the change
+    intVersion INT NOT NULL,
+    -- facility identifier after the change
+    inbFacilityId BIGINT NOT NULL,
+    -- subarea identifier after the change
+    inbSubareaId BIGINT NOT NULL,
+    -- inventory product identifier after the change
+    inbProductId BIGINT NOT NULL,
+    -- facility identifier before an update, zero otherwise
+    inbPrevFacilityId BIGINT NOT NULL,
+    -- subarea identifier before an update, zero otherwise
+    inbPrevSubareaId BIGINT NOT NULL,
+    -- inventory product identifier before an update, zero otherwise
+    inbPrevProductId BIGINT NOT NULL,
+
+
+    PRIMARY KEY (inbEventId),
+    INDEX (dtmCreated)
+) ENGINE=InnoDB;
+
@@ -22,8 +22,29 @@ CREATE TABLE tb_ItemType
     intVersion INT NOT NULL,
     -- item type name
     chvItemTypeName VARCHAR(255) NOT NULL,
+    -- account change sequence of the last write, read by get_changes_since
+    inbChangeSeq BIGINT NOT NULL DEFAULT 0,
 
 
-    PRIMARY KEY (inbMserviceId,intItemTypeId)
+    PRIMARY KEY (inbMserviceId,intItemTypeId),
+    INDEX (inbMserviceId,inbChangeSeq)
 ) ENGINE=InnoDB;
 
+-- number each insert and update from the account change sequence
+DELIMITER //
+
+CREATE TRIGGER tr_ItemType_Insert BEFORE INSERT ON tb_ItemType FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+CREATE TRIGGER tr_ItemType_Update BEFORE UPDATE ON tb_ItemType FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DELIMITER ;
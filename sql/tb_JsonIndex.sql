@@ -0,0 +1,25 @@
+use inventory;
+
+DROP TABLE IF EXISTS tb_JsonIndex;
+
+-- indexed json_data extension field
+CREATE TABLE tb_JsonIndex
+(
+
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- name of extended entity
+    chvEntityName VARCHAR(32) NOT NULL,
+    -- json path of the field
+    chvJsonPath VARCHAR(200) NOT NULL,
+    -- type of the field value, string or number
+    chvValueType VARCHAR(16) NOT NULL,
+    -- name of the generated column holding the field value
+    chvColumnName VARCHAR(64) NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+
+
+    PRIMARY KEY (inbMserviceId,chvEntityName,chvJsonPath,chvValueType)
+) ENGINE=InnoDB;
+
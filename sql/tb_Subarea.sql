@@ -32,9 +32,30 @@ CREATE TABLE tb_Subarea
     chvSubareaName VARCHAR(255) NOT NULL,
     -- data for entity ui extensions
     chvJsonData VARCHAR(8000) NOT NULL,
+    -- account change sequence of the last write, read by get_changes_since
+    inbChangeSeq BIGINT NOT NULL DEFAULT 0,
 
 
     PRIMARY KEY (inbSubareaId),
-    UNIQUE (inbMserviceId,chvSubareaName)
+    UNIQUE (inbMserviceId,inbFacilityId,inbParentSubareaId,chvSubareaName),
+    INDEX (inbMserviceId,inbChangeSeq)
 ) ENGINE=InnoDB;
 
+-- number each insert and update from the account change sequence
+DELIMITER //
+
+CREATE TRIGGER tr_Subarea_Insert BEFORE INSERT ON tb_Subarea FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+CREATE TRIGGER tr_Subarea_Update BEFORE UPDATE ON tb_Subarea FOR EACH ROW
+BEGIN
+    INSERT INTO tb_ChangeSequence (inbMserviceId, inbChangeSeq) VALUES (NEW.inbMserviceId, 1)
+        ON DUPLICATE KEY UPDATE inbChangeSeq = inbChangeSeq + 1;
+    SET NEW.inbChangeSeq = (SELECT inbChangeSeq FROM tb_ChangeSequence WHERE inbMserviceId = NEW.inbMserviceId);
+END//
+
+DELIMITER ;
@@ -0,0 +1,21 @@
+use inventory;
+
+DROP TABLE IF EXISTS tb_SubareaTypeItemType;
+
+-- item types allowed to be stored in a subarea type
+CREATE TABLE tb_SubareaTypeItemType
+(
+
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- subarea type identifier
+    intSubareaTypeId INT NOT NULL,
+    -- inventory item type identifier
+    intItemTypeId INT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+
+
+    PRIMARY KEY (inbMserviceId,intSubareaTypeId,intItemTypeId)
+) ENGINE=InnoDB;
+
@@ -0,0 +1,21 @@
+use inventory;
+
+DROP TABLE IF EXISTS tb_SubareaTypeNesting;
+
+-- subarea types allowed as children of a subarea type
+CREATE TABLE tb_SubareaTypeNesting
+(
+
+    -- mservice account identifier
+    inbMserviceId BIGINT NOT NULL,
+    -- parent subarea type identifier
+    intParentSubareaTypeId INT NOT NULL,
+    -- child subarea type identifier
+    intChildSubareaTypeId INT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+
+
+    PRIMARY KEY (inbMserviceId,intParentSubareaTypeId,intChildSubareaTypeId)
+) ENGINE=InnoDB;
+